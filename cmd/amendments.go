@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	amendDays       int
+	amendSideBySide bool
+	amendAllFiles   bool
+)
+
+var amendmentsCmd = &cobra.Command{
+	Use:   "amendments <접수번호>",
+	Short: "정정 공시가 무엇을 바꿨는지 보여줍니다",
+	Long: `공시의 최초 제출본과 모든 정정본([기재정정], [첨부정정] 등)을 공시 목록에서 찾아
+제출 순서대로 보여줍니다. 최초본과 정정본 어느 접수번호로도 조회할 수 있습니다.
+
+정정본마다 다음을 출력합니다.
+  - 정정 내역: 정정신고서의 정정사항 표 (항목, 사유, 정정 전, 정정 후)
+  - 원문 변경: 직전 제출본과 원문을 섹션별로 비교한 결과 (diff 명령과 같은 형식)
+
+공시 목록의 비고에 "정"이 붙은 공시는 이후 정정된 공시입니다.
+
+  dartcli amendments 20240312000736
+  dartcli amendments 20240312000736 --side-by-side
+  dartcli amendments 20240312000736 --format json | jq '.filings[].corrections'`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rceptNo := args[0]
+		renderer.Meta().RceptNo = rceptNo
+		if len(rceptNo) != 14 {
+			return fmt.Errorf("접수번호 형식이 올바르지 않습니다: %s (14자리)", rceptNo)
+		}
+		// 접수번호 starts with the filing date.
+		filed, err := time.Parse("20060102", rceptNo[:8])
+		if err != nil {
+			return fmt.Errorf("접수번호 형식이 올바르지 않습니다: %s", rceptNo)
+		}
+		if err := requireAPIKey(); err != nil {
+			return err
+		}
+
+		client := api.New(cfg.APIKey)
+		sel := render.FileSelection{All: amendAllFiles}
+		docs, err := fetchDocuments(client, rceptNo, sel)
+		if err != nil {
+			return err
+		}
+
+		corpCode := ""
+		if len(docs) > 0 {
+			corpCode = docs[0].CorpCode
+		}
+		if corpCode == "" {
+			// Older filings lack AREGCIK; find the filing on its date instead.
+			items, err := listMarketWide(client, filed, filed, api.ListOptions{})
+			if err != nil {
+				return fmt.Errorf("공시 목록 조회 실패: %w", err)
+			}
+			for _, it := range items {
+				if it.RceptNo == rceptNo {
+					corpCode = it.CorpCode
+				}
+			}
+			if corpCode == "" {
+				return fmt.Errorf("공시 목록에서 접수번호를 찾을 수 없습니다: %s", rceptNo)
+			}
+		}
+
+		items, err := listAll(client, api.ListOptions{
+			CorpCode:  corpCode,
+			StartDate: filed.AddDate(0, 0, -amendDays).Format("20060102"),
+			EndDate:   time.Now().Format("20060102"),
+		})
+		if err != nil {
+			return fmt.Errorf("공시 목록 조회 실패: %w", err)
+		}
+		chain := render.AmendmentChain(items, rceptNo)
+		if len(chain) == 0 {
+			return fmt.Errorf("공시 목록에서 접수번호를 찾을 수 없습니다: %s", rceptNo)
+		}
+
+		base, _ := render.AmendmentBase(chain[0].ReportNm)
+		h := &render.AmendmentHistory{CorpName: chain[0].CorpName, ReportNm: base}
+		if len(chain) == 1 {
+			return printEmpty(h, "%s %s: 정정 공시가 없습니다.", h.CorpName, base)
+		}
+
+		var prev []*render.Document
+		for i, it := range chain {
+			cur := docs
+			if it.RceptNo != rceptNo {
+				if cur, err = fetchDocuments(client, it.RceptNo, sel); err != nil {
+					return err
+				}
+			}
+			_, tag := render.AmendmentBase(it.ReportNm)
+			f := render.Amendment{RceptNo: it.RceptNo, RceptDt: it.RceptDt, ReportNm: it.ReportNm, Tag: tag}
+			if i > 0 {
+				f.Corrections = render.Corrections(cur)
+				// Every change in a correction matters, however small.
+				f.Diff = render.DiffDocuments(chain[i-1].RceptNo, it.RceptNo, prev, cur, 0)
+			}
+			h.Filings = append(h.Filings, f)
+			prev = cur
+		}
+
+		return renderer.OutputWide(h, func() string {
+			return render.AmendmentsMarkdown(h, amendSideBySide)
+		})
+	},
+}
+
+// listAll pages through every list result for opts.
+func listAll(client *api.Client, opts api.ListOptions) ([]api.DisclosureItem, error) {
+	var items []api.DisclosureItem
+	opts.PageCount = 100
+	for page := 1; ; page++ {
+		opts.PageNo = page
+		resp, err := client.GetList(opts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, resp.Items...)
+		if page >= resp.TotalPage {
+			return items, nil
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(amendmentsCmd)
+	amendmentsCmd.Flags().IntVar(&amendDays, "days", 365, "접수일 이전 N일까지 최초 제출본 검색")
+	amendmentsCmd.Flags().BoolVar(&amendSideBySide, "side-by-side", false, "원문 변경을 이전·이후 나란히 비교하는 표로 출력")
+	amendmentsCmd.Flags().BoolVar(&amendAllFiles, "all-files", false, "본문 외 첨부서류까지 모든 파일 비교 ([첨부정정] 확인용)")
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var auditYears int
+
+var auditCmd = &cobra.Command{
+	Use:   "audit <회사명 또는 종목코드>",
+	Short: "외부감사인, 감사의견, 감사보수를 조회합니다",
+	Long: `최근 N개 사업보고서에서 외부감사 정보를 모아 연도별로 보여줍니다.
+
+  - 회계감사인의 명칭 및 감사의견 → 감사인 변경, 비적정 의견 강조
+  - 감사용역체결현황               → 연도별 감사보수·감사시간
+  - 비감사용역 계약체결 현황       → 연도별 비감사용역 건수·보수`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		n := auditYears
+		if n <= 0 {
+			n = 5
+		}
+		latest := time.Now().Year() - 1
+
+		client := api.New(cfg.APIKey)
+		var sources []render.AuditSource
+		for year := latest; year > latest-n; year-- {
+			opts := api.ReportOptions{
+				CorpCode:  corpCode,
+				BsnsYear:  strconv.Itoa(year),
+				ReprtCode: api.ReprtCode("annual"),
+			}
+			src := render.AuditSource{Year: year}
+			if src.Opinions, err = client.GetAuditOpinion(opts); err != nil {
+				return fmt.Errorf("%d년 감사의견 조회 실패: %w", year, err)
+			}
+			if src.Fees, err = client.GetAuditServiceContract(opts); err != nil {
+				return fmt.Errorf("%d년 감사용역체결현황 조회 실패: %w", year, err)
+			}
+			if src.NonAudit, err = client.GetNonAuditServiceContract(opts); err != nil {
+				return fmt.Errorf("%d년 비감사용역 계약체결 현황 조회 실패: %w", year, err)
+			}
+			sources = append(sources, src)
+		}
+
+		history := render.AuditHistory(sources)
+		// Restated 전기/전전기 rows may reach past the requested window.
+		var years []render.AuditYear
+		for _, y := range history {
+			if y.Year > latest-n {
+				years = append(years, y)
+			}
+		}
+		if len(years) == 0 {
+			return printEmpty([]render.AuditYear{}, "%s: 최근 %d년간 감사 정보가 없습니다.", corpName, n)
+		}
+
+		return renderer.Output(years, func() string {
+			return render.AuditMarkdown(corpName, years)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(auditCmd)
+	auditCmd.Flags().IntVar(&auditYears, "years", 5, "최근 N개 사업연도")
+}
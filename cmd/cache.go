@@ -2,9 +2,9 @@ package cmd
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/seapy/dartcli/internal/cache"
+	"github.com/seapy/dartcli/internal/render"
 	"github.com/spf13/cobra"
 )
 
@@ -31,45 +31,37 @@ func cacheRefresh() error {
 	if err := requireAPIKey(); err != nil {
 		return err
 	}
-	fmt.Println("Corp code 캐시를 갱신하는 중...")
+	if !renderer.Machine() {
+		fmt.Println("Corp code 캐시를 갱신하는 중...")
+	}
 	store, err := cache.Refresh(cfg.APIKey)
 	if err != nil {
 		return fmt.Errorf("캐시 갱신 실패: %w", err)
 	}
+	if renderer.Machine() {
+		return cacheStatus()
+	}
 	fmt.Printf("완료: %d개 기업 정보가 캐시되었습니다.\n", len(store.All))
 	return nil
 }
 
 func cacheStatus() error {
-	exists, modTime, stale, err := cache.Status()
+	status, err := cache.GetStatus()
 	if err != nil {
 		return err
 	}
-
-	var sb strings.Builder
-	sb.WriteString("# 캐시 상태\n\n")
-
-	if !exists {
-		sb.WriteString("캐시 파일이 없습니다. `dartcli cache refresh`로 초기화하세요.\n")
-	} else {
-		path, _ := cache.CorpCodePath()
-		staleLabel := "최신"
-		if stale {
-			staleLabel = "**갱신 필요**"
-		}
-		fmt.Fprintf(&sb, "| 항목 | 값 |\n|------|----|\n")
-		fmt.Fprintf(&sb, "| 파일 | `%s` |\n", path)
-		fmt.Fprintf(&sb, "| 최종 갱신 | %s |\n", modTime.Format("2006-01-02 15:04:05"))
-		fmt.Fprintf(&sb, "| 상태 | %s |\n", staleLabel)
-	}
-
-	return renderer.Print(sb.String())
+	return renderer.Output(status, func() string {
+		return render.CacheStatusMarkdown(status)
+	})
 }
 
 func cacheClear() error {
 	if err := cache.Clear(); err != nil {
 		return fmt.Errorf("캐시 삭제 실패: %w", err)
 	}
+	if renderer.Machine() {
+		return cacheStatus()
+	}
 	fmt.Println("캐시가 삭제되었습니다.")
 	return nil
 }
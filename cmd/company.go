@@ -22,8 +22,9 @@ var companyCmd = &cobra.Command{
 			return err
 		}
 
-		md := render.CompanyMarkdown(info)
-		return renderer.Print(md)
+		return renderer.Output(info, func() string {
+			return render.CompanyMarkdown(info)
+		})
 	},
 }
 
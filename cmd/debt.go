@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	debtYear   int
+	debtPeriod string
+)
+
+// debtData is the --format json|yaml payload.
+type debtData struct {
+	Instruments []render.DebtInstrument `json:"instruments"`
+	Issues      []api.DebtIssuance      `json:"issues"`
+}
+
+var debtCmd = &cobra.Command{
+	Use:   "debt <회사명 또는 종목코드>",
+	Short: "채무증권 미상환 잔액과 발행실적을 조회합니다",
+	Long: `정기보고서에 기재된 채무증권 정보를 모아 만기 구조로 보여줍니다.
+
+  - 회사채 / 기업어음증권 / 단기사채 / 신종자본증권 / 조건부자본증권 미상환 잔액
+    → 잔여만기 구간(1년 이하, 1~5년, 5~10년, 10년 초과)별 합산
+  - 채무증권 발행실적 → 증권종류별 건수·권면총액 및 발행 내역`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		year := debtYear
+		if year == 0 {
+			year = time.Now().Year() - 1
+		}
+		opts := api.ReportOptions{
+			CorpCode:  corpCode,
+			BsnsYear:  strconv.Itoa(year),
+			ReprtCode: api.ReprtCode(debtPeriod),
+		}
+
+		client := api.New(cfg.APIKey)
+
+		bonds, err := client.GetBondBalance(opts)
+		if err != nil {
+			return fmt.Errorf("회사채 미상환 잔액 조회 실패: %w", err)
+		}
+		cps, err := client.GetCommercialPaperBalance(opts)
+		if err != nil {
+			return fmt.Errorf("기업어음증권 미상환 잔액 조회 실패: %w", err)
+		}
+		stbs, err := client.GetShortTermBondBalance(opts)
+		if err != nil {
+			return fmt.Errorf("단기사채 미상환 잔액 조회 실패: %w", err)
+		}
+		hybrids, err := client.GetHybridCapitalBalance(opts)
+		if err != nil {
+			return fmt.Errorf("신종자본증권 미상환 잔액 조회 실패: %w", err)
+		}
+		cocos, err := client.GetContingentCapitalBalance(opts)
+		if err != nil {
+			return fmt.Errorf("조건부자본증권 미상환 잔액 조회 실패: %w", err)
+		}
+		issues, err := client.GetDebtIssuance(opts)
+		if err != nil {
+			return fmt.Errorf("채무증권 발행실적 조회 실패: %w", err)
+		}
+
+		if len(bonds)+len(cps)+len(stbs)+len(hybrids)+len(cocos)+len(issues) == 0 {
+			return printEmpty(debtData{Instruments: []render.DebtInstrument{}, Issues: []api.DebtIssuance{}},
+				"%s: %s년 %s 채무증권 정보가 없습니다.", corpName, opts.BsnsYear, api.PeriodLabel(debtPeriod))
+		}
+
+		instruments := []render.DebtInstrument{
+			render.BondInstrument(bonds),
+			render.CommercialPaperInstrument(cps),
+			render.ShortTermBondInstrument(stbs),
+			render.HybridCapitalInstrument(hybrids),
+			render.ContingentCapitalInstrument(cocos),
+		}
+
+		return renderer.Output(debtData{instruments, issues}, func() string {
+			return render.DebtMarkdown(corpName, opts.BsnsYear, api.PeriodLabel(debtPeriod), instruments, issues)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(debtCmd)
+	debtCmd.Flags().IntVar(&debtYear, "year", 0, "사업연도 (기본: 작년)")
+	debtCmd.Flags().StringVar(&debtPeriod, "period", "annual", "기간 (annual|q1|half|q3)")
+}
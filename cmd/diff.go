@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffSideBySide bool
+	diffAll        bool
+	diffThreshold  float64
+	diffSection    string
+	diffAllFiles   bool
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff <이전 접수번호> <이후 접수번호>",
+	Short: "두 공시 원문을 섹션별로 비교합니다",
+	Long: `두 공시의 원문을 파싱하여 섹션 경로(예: "II. 사업의 내용 > 2. 주요 제품")로
+맞춘 뒤 섹션마다 단어 단위로 비교합니다. 전년도 사업보고서와 비교할 때 유용합니다.
+
+섹션 번호가 바뀌어도 제목이 같으면 같은 섹션으로 맞춥니다. 표는 행 단위로
+비교하므로 바뀐 수치가 단어 단위로 표시됩니다.
+
+먼저 추가·삭제·변경된 섹션 요약을 보여주고, 변경된 단어 비율이 --threshold
+(기본 5%) 이상인 주요 변경 섹션의 차이를 출력합니다.
+  - 기본: 통합 diff ([-삭제-]{+추가+} 표시)
+  - --side-by-side: 이전·이후를 나란히 비교하는 표
+
+  dartcli diff 20240312000736 20250311001085
+  dartcli diff 20240312000736 20250311001085 --section "사업의 내용" --side-by-side
+  dartcli diff 20240312000736 20250311001085 --format json | jq '.sections[] | select(.material)'`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if diffThreshold < 0 || diffThreshold > 100 {
+			return fmt.Errorf("--threshold 는 0~100 사이여야 합니다")
+		}
+		if err := requireAPIKey(); err != nil {
+			return err
+		}
+
+		client := api.New(cfg.APIKey)
+		sel := render.FileSelection{All: diffAllFiles}
+		var sides [2][]*render.Document
+		for i, rceptNo := range args {
+			docs, err := fetchDocuments(client, rceptNo, sel)
+			if err != nil {
+				return err
+			}
+			if diffSection != "" {
+				docs = selectSections(docs, diffSection)
+				if len(docs) == 0 {
+					return fmt.Errorf("섹션을 찾을 수 없습니다: %s (%s, view --toc로 목차를 확인하세요)", diffSection, rceptNo)
+				}
+			}
+			sides[i] = docs
+		}
+
+		dd := render.DiffDocuments(args[0], args[1], sides[0], sides[1], diffThreshold/100)
+		return renderer.OutputWide(dd, func() string {
+			return render.DiffMarkdown(dd, diffSideBySide, diffAll)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+	diffCmd.Flags().BoolVar(&diffSideBySide, "side-by-side", false, "이전·이후를 나란히 비교하는 표로 출력")
+	diffCmd.Flags().BoolVar(&diffAll, "all", false, "경미한 변경 섹션의 차이도 출력")
+	diffCmd.Flags().Float64Var(&diffThreshold, "threshold", render.DefaultDiffThreshold*100, "주요 변경으로 볼 변경 단어 비율 (%)")
+	diffCmd.Flags().StringVar(&diffSection, "section", "", "비교할 섹션 (view --section 과 같은 형식)")
+	diffCmd.Flags().BoolVar(&diffAllFiles, "all-files", false, "본문 외 첨부서류까지 모든 파일 비교")
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	distressDays   int
+	distressMarket string
+)
+
+// marketWideMaxDays is DART's maximum search range for list queries
+// without a corp_code.
+const marketWideMaxDays = 90
+
+var distressCmd = &cobra.Command{
+	Use:   "distress",
+	Short: "시장 전체의 부실 징후 공시를 조회합니다",
+	Long: `상장사 전체의 주요사항보고서 중 다음 부실 징후 공시를 찾아 모아 보여줍니다.
+
+  - 부도발생
+  - 영업정지
+  - 회생절차 개시신청
+  - 해산사유 발생
+  - 채권은행 등의 관리절차 개시
+
+시장 전체 공시 목록(주요사항보고서)에서 해당 보고서를 골라낸 뒤,
+보고서별 주요정보 API로 발생일·내용·사유를 채웁니다.
+
+  dartcli distress --days 7
+  dartcli distress --days 30 --market K   # 코스닥만`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAPIKey(); err != nil {
+			return err
+		}
+		market := strings.ToUpper(distressMarket)
+		switch market {
+		case "", "Y", "K", "N":
+		default:
+			return fmt.Errorf("알 수 없는 시장 구분: %s (Y=유가증권, K=코스닥, N=코넥스)", distressMarket)
+		}
+
+		now := time.Now()
+		endDate := now.Format("20060102")
+		startDate := now.AddDate(0, 0, -distressDays).Format("20060102")
+
+		client := api.New(cfg.APIKey)
+		items, err := listMarketWide(client, now.AddDate(0, 0, -distressDays), now, api.ListOptions{
+			PblntfTy:       "B",
+			PblntfDetailTy: "B001",
+			CorpCls:        market,
+		})
+		if err != nil {
+			return fmt.Errorf("주요사항보고서 목록 조회 실패: %w", err)
+		}
+
+		var events []render.DistressEvent
+		for _, item := range items {
+			typ := render.DistressType(item.ReportNm)
+			if typ == "" || item.StockCode == "" {
+				continue
+			}
+			e, err := distressDetail(client, item, typ)
+			if err != nil {
+				printWarning(fmt.Sprintf("%s %s 상세 조회 실패: %v", item.CorpName, item.RceptNo, err))
+			}
+			events = append(events, e)
+		}
+
+		if len(events) == 0 {
+			return printEmpty([]render.DistressEvent{}, "%s ~ %s 기간에 부실 징후 공시가 없습니다.", startDate, endDate)
+		}
+
+		return renderer.Output(events, func() string {
+			return render.DistressMarkdown(startDate, endDate, market, events)
+		})
+	},
+}
+
+// listMarketWide pages through market-wide list results, splitting the
+// range into windows DART accepts without a corp_code.
+func listMarketWide(client *api.Client, from, to time.Time, base api.ListOptions) ([]api.DisclosureItem, error) {
+	var items []api.DisclosureItem
+	for end := to; !end.Before(from); end = end.AddDate(0, 0, -marketWideMaxDays) {
+		start := end.AddDate(0, 0, -marketWideMaxDays+1)
+		if start.Before(from) {
+			start = from
+		}
+		opts := base
+		opts.StartDate = start.Format("20060102")
+		opts.EndDate = end.Format("20060102")
+		opts.PageCount = 100
+		for page := 1; ; page++ {
+			opts.PageNo = page
+			resp, err := client.GetList(opts)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, resp.Items...)
+			if page >= resp.TotalPage {
+				break
+			}
+		}
+	}
+	return items, nil
+}
+
+// distressDetail fetches the structured 주요사항보고서 row for a list item.
+// The returned event is usable even when err is non-nil.
+func distressDetail(client *api.Client, item api.DisclosureItem, typ string) (render.DistressEvent, error) {
+	opts := api.EventOptions{CorpCode: item.CorpCode, StartDate: item.RceptDt, EndDate: item.RceptDt}
+	switch typ {
+	case "부도발생":
+		rows, err := client.GetDefaultOccurrences(opts)
+		return render.DefaultEvent(item, render.MatchReceipt(rows, item.RceptNo)), err
+	case "영업정지":
+		rows, err := client.GetBusinessSuspensions(opts)
+		return render.SuspensionEvent(item, render.MatchReceipt(rows, item.RceptNo)), err
+	case "회생절차개시신청":
+		rows, err := client.GetRehabilitationFilings(opts)
+		return render.RehabilitationEvent(item, render.MatchReceipt(rows, item.RceptNo)), err
+	case "해산사유발생":
+		rows, err := client.GetDissolutionCauses(opts)
+		return render.DissolutionEvent(item, render.MatchReceipt(rows, item.RceptNo)), err
+	default:
+		rows, err := client.GetCreditorManagements(opts)
+		return render.CreditorManagementEvent(item, render.MatchReceipt(rows, item.RceptNo)), err
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(distressCmd)
+	distressCmd.Flags().IntVar(&distressDays, "days", 7, "최근 N일")
+	distressCmd.Flags().StringVar(&distressMarket, "market", "", "시장 구분 (Y=유가증권|K=코스닥|N=코넥스, 기본: 전체)")
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	eventsDays  int
+	eventsStart string
+	eventsEnd   string
+)
+
+var eventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "주요사항보고서(사채 발행결정 등)를 조회합니다",
+	Long: `주요사항보고서의 구조화된 주요정보를 조회합니다.
+
+하위 명령어:
+  cb       전환사채권 발행결정
+  bw       신주인수권부사채권 발행결정
+  eb       교환사채권 발행결정
+  mna      합병·분할·주식교환·양수도 결정
+  capital  유상증자·무상증자·유무상증자·감자 결정`,
+}
+
+// eventOptions resolves the shared --days/--start/--end flags.
+func eventOptions(corpCode string) api.EventOptions {
+	endDate := eventsEnd
+	if endDate == "" {
+		endDate = time.Now().Format("20060102")
+	}
+	startDate := eventsStart
+	if startDate == "" {
+		startDate = time.Now().AddDate(0, 0, -eventsDays).Format("20060102")
+	}
+	return api.EventOptions{CorpCode: corpCode, StartDate: startDate, EndDate: endDate}
+}
+
+// shareCounter looks up 발행주식총수 from annual reports, caching per year.
+type shareCounter struct {
+	client   *api.Client
+	corpCode string
+	byYear   map[int]int64
+}
+
+func newShareCounter(client *api.Client, corpCode string) *shareCounter {
+	return &shareCounter{client: client, corpCode: corpCode, byYear: map[int]int64{}}
+}
+
+// before returns the issued share count from the last annual report filed
+// before the given decision year. Lookup failures yield 0.
+func (s *shareCounter) before(year int) int64 {
+	if year == 0 {
+		year = time.Now().Year()
+	}
+	report := year - 1
+	if n, ok := s.byYear[report]; ok {
+		return n
+	}
+	rows, err := s.client.GetStockTotal(api.ReportOptions{
+		CorpCode:  s.corpCode,
+		BsnsYear:  strconv.Itoa(report),
+		ReprtCode: api.ReprtCode("annual"),
+	})
+	var n int64
+	if err == nil {
+		n = render.IssuedShares(rows)
+	}
+	s.byYear[report] = n
+	return n
+}
+
+// linkedBondCmd builds the cb/bw/eb subcommands, which differ only in the
+// endpoint they call.
+func linkedBondCmd(kind string, fetch func(*api.Client, api.EventOptions) ([]render.LinkedBond, error)) *cobra.Command {
+	label := render.LinkedBondLabels[kind]
+	return &cobra.Command{
+		Use:   fmt.Sprintf("%s <회사명 또는 종목코드>", strings.ToLower(kind)),
+		Short: label + "권 발행결정을 조회합니다",
+		Long: label + `권 발행결정의 권면총액, 표면/만기 이자율, 전환(행사·교환)가액,
+리픽싱 최저 조정가액, 청구기간과 잠재 희석률을 보여줍니다.
+
+잠재 희석률은 발행될 주식수를 결의일 직전 사업연도 사업보고서의
+발행주식총수로 나눈 비율입니다. 총수를 알 수 없으면 공시에 기재된
+주식총수 대비 비율을 사용합니다.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			corpCode, corpName, err := resolveCorpCode(args[0])
+			if err != nil {
+				return err
+			}
+
+			opts := eventOptions(corpCode)
+			client := api.New(cfg.APIKey)
+			bonds, err := fetch(client, opts)
+			if err != nil {
+				return fmt.Errorf("%s권 발행결정 조회 실패: %w", label, err)
+			}
+			if len(bonds) == 0 {
+				return printEmpty([]render.LinkedBond{}, "%s: %s ~ %s 기간에 %s권 발행결정이 없습니다.",
+					corpName, opts.StartDate, opts.EndDate, label)
+			}
+
+			shares := newShareCounter(client, corpCode)
+			for i := range bonds {
+				// EBs keep the filing's ratio, so skip the share-count lookup.
+				var outstanding int64
+				if kind != "EB" {
+					outstanding = shares.before(render.DecisionYear(bonds[i].BoardDate))
+				}
+				bonds[i].SetOutstanding(outstanding)
+			}
+
+			return renderer.Output(bonds, func() string {
+				return render.LinkedBondsMarkdown(corpName, kind, opts.StartDate, opts.EndDate, bonds)
+			})
+		},
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(eventsCmd)
+	eventsCmd.PersistentFlags().IntVar(&eventsDays, "days", 1825, "최근 N일")
+	eventsCmd.PersistentFlags().StringVar(&eventsStart, "start", "", "시작일 YYYYMMDD")
+	eventsCmd.PersistentFlags().StringVar(&eventsEnd, "end", "", "종료일 YYYYMMDD (기본: 오늘)")
+
+	eventsCmd.AddCommand(
+		linkedBondCmd("CB", func(c *api.Client, o api.EventOptions) ([]render.LinkedBond, error) {
+			rows, err := c.GetConvertibleBondDecisions(o)
+			return render.ConvertibleBonds(rows), err
+		}),
+		linkedBondCmd("BW", func(c *api.Client, o api.EventOptions) ([]render.LinkedBond, error) {
+			rows, err := c.GetWarrantBondDecisions(o)
+			return render.WarrantBonds(rows), err
+		}),
+		linkedBondCmd("EB", func(c *api.Client, o api.EventOptions) ([]render.LinkedBond, error) {
+			rows, err := c.GetExchangeableBondDecisions(o)
+			return render.ExchangeableBonds(rows), err
+		}),
+	)
+}
@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var eventsCapitalCmd = &cobra.Command{
+	Use:   "capital <회사명 또는 종목코드>",
+	Short: "유상증자·무상증자·유무상증자·감자 결정을 조회합니다",
+	Long: `증자·감자 결정을 모아 신주 수, 증자 방식(주주배정/일반공모/제3자배정),
+자금용도, 배정기준일과 희석률을 보여줍니다.
+
+희석률은 공시에 기재된 증자 전 발행주식총수(보통주) 대비 신주 비율입니다.
+기재가 없으면 직전 사업연도 사업보고서의 발행주식총수를 사용합니다.
+유상증자 발행가는 조달금액을 신주 수로 나눈 추정치입니다.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		opts := eventOptions(corpCode)
+		client := api.New(cfg.APIKey)
+
+		paid, err := client.GetPaidInCapitalDecisions(opts)
+		if err != nil {
+			return fmt.Errorf("유상증자 결정 조회 실패: %w", err)
+		}
+		bonus, err := client.GetBonusIssueDecisions(opts)
+		if err != nil {
+			return fmt.Errorf("무상증자 결정 조회 실패: %w", err)
+		}
+		mixed, err := client.GetMixedCapitalDecisions(opts)
+		if err != nil {
+			return fmt.Errorf("유무상증자 결정 조회 실패: %w", err)
+		}
+		reductions, err := client.GetCapitalReductionDecisions(opts)
+		if err != nil {
+			return fmt.Errorf("감자 결정 조회 실패: %w", err)
+		}
+
+		events := render.CapitalEvents(paid, bonus, mixed, reductions)
+		if len(events) == 0 {
+			return printEmpty([]render.CapitalEvent{}, "%s: %s ~ %s 기간에 증자·감자 결정이 없습니다.",
+				corpName, opts.StartDate, opts.EndDate)
+		}
+
+		shares := newShareCounter(client, corpCode)
+		for i := range events {
+			if events[i].PreShares == 0 {
+				events[i].SetPreShares(shares.before(render.DecisionYear(events[i].FiledDate)))
+			}
+		}
+
+		return renderer.Output(events, func() string {
+			return render.CapitalMarkdown(corpName, opts.StartDate, opts.EndDate, events)
+		})
+	},
+}
+
+func init() {
+	eventsCmd.AddCommand(eventsCapitalCmd)
+}
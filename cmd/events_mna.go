@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var eventsMnACmd = &cobra.Command{
+	Use:   "mna <회사명 또는 종목코드>",
+	Short: "합병·분할·주식교환·양수도 결정을 조회합니다",
+	Long: `합병, 분할, 분할합병, 주식교환·이전, 영업양수도, 유형자산 양수도,
+타법인 주식 양수도 결정을 모두 조회해 하나의 타임라인으로 보여줍니다.
+
+각 결정의 상대방, 대가, 비율, 주요 일정과 계열회사 여부를 표시합니다.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		opts := eventOptions(corpCode)
+		client := api.New(cfg.APIKey)
+
+		var src render.MnASources
+		if src.Mergers, err = client.GetMergerDecisions(opts); err != nil {
+			return fmt.Errorf("회사합병 결정 조회 실패: %w", err)
+		}
+		if src.Splits, err = client.GetSplitDecisions(opts); err != nil {
+			return fmt.Errorf("회사분할 결정 조회 실패: %w", err)
+		}
+		if src.SplitMergers, err = client.GetSplitMergerDecisions(opts); err != nil {
+			return fmt.Errorf("회사분할합병 결정 조회 실패: %w", err)
+		}
+		if src.ShareExchanges, err = client.GetShareExchangeDecisions(opts); err != nil {
+			return fmt.Errorf("주식교환·이전 결정 조회 실패: %w", err)
+		}
+		if src.BusinessAcquisition, err = client.GetBusinessAcquisitionDecisions(opts); err != nil {
+			return fmt.Errorf("영업양수 결정 조회 실패: %w", err)
+		}
+		if src.BusinessTransfer, err = client.GetBusinessTransferDecisions(opts); err != nil {
+			return fmt.Errorf("영업양도 결정 조회 실패: %w", err)
+		}
+		if src.AssetAcquisition, err = client.GetAssetAcquisitionDecisions(opts); err != nil {
+			return fmt.Errorf("유형자산 양수 결정 조회 실패: %w", err)
+		}
+		if src.AssetTransfer, err = client.GetAssetTransferDecisions(opts); err != nil {
+			return fmt.Errorf("유형자산 양도 결정 조회 실패: %w", err)
+		}
+		if src.StockAcquisition, err = client.GetStockAcquisitionDecisions(opts); err != nil {
+			return fmt.Errorf("타법인 주식 양수결정 조회 실패: %w", err)
+		}
+		if src.StockTransfer, err = client.GetStockTransferDecisions(opts); err != nil {
+			return fmt.Errorf("타법인 주식 양도결정 조회 실패: %w", err)
+		}
+
+		events := render.MnATimeline(src)
+		if len(events) == 0 {
+			return printEmpty([]render.MnAEvent{}, "%s: %s ~ %s 기간에 합병·분할·양수도 결정이 없습니다.",
+				corpName, opts.StartDate, opts.EndDate)
+		}
+
+		return renderer.Output(events, func() string {
+			return render.MnAMarkdown(corpName, opts.StartDate, opts.EndDate, events)
+		})
+	},
+}
+
+func init() {
+	eventsCmd.AddCommand(eventsMnACmd)
+}
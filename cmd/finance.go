@@ -47,18 +47,18 @@ var financeCmd = &cobra.Command{
 		}
 
 		if len(resp.Items) == 0 {
-			fmt.Printf("%s: %s년 %s %s 재무정보가 없습니다.\n",
+			return printEmpty(render.FinanceStatements{}, "%s: %s년 %s %s 재무정보가 없습니다.",
 				corpName, yearStr, api.FsDivLabel(fsDiv), api.PeriodLabel(financePeriod))
-			return nil
 		}
 
-		md := render.FinanceMarkdown(
-			corpName, yearStr,
-			api.PeriodLabel(financePeriod),
-			api.FsDivLabel(fsDiv),
-			resp.Items,
-		)
-		return renderer.Print(md)
+		return renderer.Output(render.FinanceStatements(resp.Items), func() string {
+			return render.FinanceMarkdown(
+				corpName, yearStr,
+				api.PeriodLabel(financePeriod),
+				api.FsDivLabel(fsDiv),
+				resp.Items,
+			)
+		})
 	},
 }
 
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+// holdingsData is the --format json|yaml payload.
+type holdingsData struct {
+	Holders []render.HolderPosition `json:"holders"`
+	Reports []api.MajorStockReport  `json:"reports"`
+}
+
+var holdingsCmd = &cobra.Command{
+	Use:   "holdings <회사명 또는 종목코드>",
+	Short: "5% 대량보유 상황보고를 조회합니다",
+	Long: `대량보유 상황보고(5% 룰) 내역을 조회합니다.
+
+보고자별 최근 보유주식수·보유비율을 먼저 보여주고,
+이어서 보고서별 보유주식수, 증감, 보고사유, 접수번호를 나열합니다.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := api.New(cfg.APIKey)
+		reports, err := client.GetMajorStock(corpCode)
+		if err != nil {
+			return fmt.Errorf("대량보유 상황보고 조회 실패: %w", err)
+		}
+		if len(reports) == 0 {
+			return printEmpty(holdingsData{Holders: []render.HolderPosition{}, Reports: []api.MajorStockReport{}},
+				"%s: 대량보유 상황보고 내역이 없습니다.", corpName)
+		}
+
+		data := holdingsData{render.LatestHoldings(reports), render.NewestReports(reports)}
+		return renderer.Output(data, func() string {
+			return render.HoldingsMarkdown(corpName, reports)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(holdingsCmd)
+}
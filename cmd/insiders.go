@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	insidersDays      int
+	insidersThreshold float64
+)
+
+var insidersCmd = &cobra.Command{
+	Use:   "insiders <회사명 또는 종목코드>",
+	Short: "임원·주요주주 소유보고를 조회합니다",
+	Long: `임원·주요주주 특정증권등 소유상황보고를 요약합니다.
+
+  - 보고자별 / 월별 매수·매도·순매수 합계
+  - 보고서별 변동 수량과 직전 보유량 대비 변동률
+  - 변동률이 --threshold 이상이거나 신규 보유인 보고는 강조 표시`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		client := api.New(cfg.APIKey)
+		reports, err := client.GetExecutiveStock(corpCode)
+		if err != nil {
+			return fmt.Errorf("임원·주요주주 소유보고 조회 실패: %w", err)
+		}
+
+		since := time.Now().AddDate(0, 0, -insidersDays).Format("20060102")
+		recent := render.InsidersSince(reports, since)
+		if len(recent) == 0 {
+			return printEmpty(render.InsiderSummary{}, "%s: 최근 %d일간 임원·주요주주 소유보고가 없습니다.", corpName, insidersDays)
+		}
+
+		summary := render.SummarizeInsiders(recent, insidersThreshold)
+		return renderer.Output(summary, func() string {
+			return render.InsidersMarkdown(corpName, insidersDays, summary)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(insidersCmd)
+	insidersCmd.Flags().IntVar(&insidersDays, "days", 365, "최근 N일")
+	insidersCmd.Flags().Float64Var(&insidersThreshold, "threshold", 10, "대규모 변동 기준 (직전 보유량 대비 %)")
+}
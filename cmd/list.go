@@ -56,8 +56,7 @@ var listCmd = &cobra.Command{
 		}
 
 		if len(resp.Items) == 0 {
-			fmt.Printf("%s: %s ~ %s 기간에 공시된 내역이 없습니다.\n", corpName, startDate, endDate)
-			return nil
+			return printEmpty(render.DisclosureList{}, "%s: %s ~ %s 기간에 공시된 내역이 없습니다.", corpName, startDate, endDate)
 		}
 
 		items := resp.Items
@@ -65,8 +64,9 @@ var listCmd = &cobra.Command{
 			items = items[:listLimit]
 		}
 
-		md := render.ListMarkdown(corpName, items)
-		return renderer.Print(md)
+		return renderer.Output(render.DisclosureList(items), func() string {
+			return render.ListMarkdown(corpName, items)
+		})
 	},
 }
 
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var litigationDays int
+
+// litigationData is the --format json|yaml payload.
+type litigationData struct {
+	Equity   render.Equity    `json:"equity"`
+	Lawsuits []render.Lawsuit `json:"lawsuits"`
+}
+
+var litigationCmd = &cobra.Command{
+	Use:   "litigation <회사명 또는 종목코드>",
+	Short: "소송 등의 제기 공시를 조회합니다",
+	Long: `주요사항보고서 중 소송 등의 제기 공시를 모아 원고, 청구금액, 관할법원,
+제기일과 자기자본 대비 청구금액 비율을 보여줍니다.
+
+청구금액은 청구내용 문구에서 원화 금액을 찾아 그중 가장 큰 값을 사용합니다.
+자기자본은 가장 최근 정기보고서 재무정보의 자본총계(연결 우선)입니다.
+청구금액이 큰 순서로 정렬하며, 금액이 없는 건은 뒤에 둡니다.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		opts := api.EventOptions{
+			CorpCode:  corpCode,
+			StartDate: now.AddDate(0, 0, -litigationDays).Format("20060102"),
+			EndDate:   now.Format("20060102"),
+		}
+
+		client := api.New(cfg.APIKey)
+		rows, err := client.GetLawsuitFilings(opts)
+		if err != nil {
+			return fmt.Errorf("소송 등의 제기 조회 실패: %w", err)
+		}
+		if len(rows) == 0 {
+			return printEmpty(litigationData{Lawsuits: []render.Lawsuit{}}, "%s: %s ~ %s 기간에 소송 등의 제기 공시가 없습니다.",
+				corpName, opts.StartDate, opts.EndDate)
+		}
+
+		equity := latestEquity(client, corpCode, now)
+		suits := render.Lawsuits(rows, equity.Amount)
+		return renderer.Output(litigationData{equity, suits}, func() string {
+			return render.LitigationMarkdown(corpName, opts.StartDate, opts.EndDate, equity, suits)
+		})
+	},
+}
+
+// latestEquity walks back through periodic reports, newest first, and
+// returns 자본총계 from the first one that has it. Failures yield zero.
+func latestEquity(client *api.Client, corpCode string, now time.Time) render.Equity {
+	periods := []string{"q3", "half", "q1", "annual"}
+	year := now.Year()
+	for i := 0; i < 8; i++ {
+		period := periods[i%4]
+		if period == "annual" {
+			year--
+		}
+		resp, err := client.GetFinance(api.FinanceOptions{
+			CorpCode:  corpCode,
+			BsnsYear:  strconv.Itoa(year),
+			ReprtCode: api.ReprtCode(period),
+		})
+		if err != nil {
+			continue
+		}
+		if amount, fsDiv := render.EquityFromFinance(resp.Items); amount != 0 {
+			return render.Equity{
+				Amount: amount,
+				Source: fmt.Sprintf("%d년 %s %s", year, api.PeriodLabel(period), api.FsDivLabel(fsDiv)),
+			}
+		}
+	}
+	return render.Equity{}
+}
+
+func init() {
+	rootCmd.AddCommand(litigationCmd)
+	litigationCmd.Flags().IntVar(&litigationDays, "days", 1095, "최근 N일")
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	offeringDays  int
+	offeringStart string
+	offeringEnd   string
+)
+
+var offeringCmd = &cobra.Command{
+	Use:   "offering <회사명|종목코드|접수번호>",
+	Short: "증권신고서 주요정보(공모 구조·인수인·자금용도·일정)를 조회합니다",
+	Long: `증권신고서 주요정보 API로 지분증권·채무증권·증권예탁증권·합병·분할·
+주식의포괄적교환이전 신고서를 조회해 보여줍니다.
+
+  - 일정: 청약일, 납입일, 배정기준일 등
+  - 증권의 종류: 수량, 모집(매출)가액, 총액, 방법
+  - 인수인 정보와 인수수수료 합계(모집총액 대비 비율)
+  - 자금의 사용목적과 항목별 비중
+
+14자리 접수번호를 주면 해당 신고서 하나만 보여줍니다.
+
+  dartcli offering 에코프로비엠
+  dartcli offering 삼성전자 --days 730
+  dartcli offering 20240115000123`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := requireAPIKey(); err != nil {
+			return err
+		}
+		client := api.New(cfg.APIKey)
+
+		var (
+			corpCode, corpName, rceptNo string
+			opts                        api.EventOptions
+		)
+		if isRceptNo(args[0]) {
+			rceptNo = args[0]
+			item, err := findFiling(client, rceptNo, "C")
+			if err != nil {
+				return err
+			}
+			corpCode, corpName = item.CorpCode, item.CorpName
+			meta := renderer.Meta()
+			meta.CorpCode, meta.CorpName, meta.RceptNo = corpCode, corpName, rceptNo
+			opts = api.EventOptions{CorpCode: corpCode, StartDate: item.RceptDt, EndDate: item.RceptDt}
+		} else {
+			var err error
+			corpCode, corpName, err = resolveCorpCode(args[0])
+			if err != nil {
+				return err
+			}
+			opts = offeringOptions(corpCode)
+		}
+
+		var offerings []render.Offering
+		for _, kind := range api.RegistrationKinds {
+			groups, err := client.GetRegistration(kind.Path, opts)
+			if err != nil {
+				return fmt.Errorf("%s 증권신고서 조회 실패: %w", kind.Name, err)
+			}
+			for _, o := range render.SplitOfferings(kind.Name, groups) {
+				if rceptNo == "" || o.RceptNo == rceptNo {
+					offerings = append(offerings, o)
+				}
+			}
+		}
+
+		if len(offerings) == 0 {
+			if rceptNo != "" {
+				return printEmpty([]render.Offering{}, "%s: 접수번호 %s 의 증권신고서 주요정보가 없습니다.", corpName, rceptNo)
+			}
+			return printEmpty([]render.Offering{}, "%s: %s ~ %s 기간에 증권신고서가 없습니다.", corpName, opts.StartDate, opts.EndDate)
+		}
+
+		return renderer.OutputWide(offerings, func() string {
+			return render.OfferingMarkdown(corpName, offerings)
+		})
+	},
+}
+
+// isRceptNo reports whether s looks like a 14-digit DART 접수번호.
+func isRceptNo(s string) bool {
+	if len(s) != 14 {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// findFiling locates a filing in the market-wide list by its 접수번호,
+// whose first 8 digits are the filing date.
+func findFiling(client *api.Client, rceptNo, pblntfTy string) (api.DisclosureItem, error) {
+	day, err := time.Parse("20060102", rceptNo[:8])
+	if err != nil {
+		return api.DisclosureItem{}, fmt.Errorf("잘못된 접수번호: %s", rceptNo)
+	}
+	items, err := listMarketWide(client, day, day, api.ListOptions{PblntfTy: pblntfTy})
+	if err != nil {
+		return api.DisclosureItem{}, fmt.Errorf("공시 목록 조회 실패: %w", err)
+	}
+	for _, item := range items {
+		if item.RceptNo == rceptNo {
+			return item, nil
+		}
+	}
+	return api.DisclosureItem{}, fmt.Errorf("공시를 찾을 수 없습니다: %s", rceptNo)
+}
+
+func offeringOptions(corpCode string) api.EventOptions {
+	endDate := offeringEnd
+	if endDate == "" {
+		endDate = time.Now().Format("20060102")
+	}
+	startDate := offeringStart
+	if startDate == "" {
+		startDate = time.Now().AddDate(0, 0, -offeringDays).Format("20060102")
+	}
+	return api.EventOptions{CorpCode: corpCode, StartDate: startDate, EndDate: endDate}
+}
+
+func init() {
+	rootCmd.AddCommand(offeringCmd)
+	offeringCmd.Flags().IntVar(&offeringDays, "days", 365, "최근 N일")
+	offeringCmd.Flags().StringVar(&offeringStart, "start", "", "시작일 YYYYMMDD")
+	offeringCmd.Flags().StringVar(&offeringEnd, "end", "", "종료일 YYYYMMDD (기본: 오늘)")
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var proceedsYears int
+
+var proceedsCmd = &cobra.Command{
+	Use:   "proceeds <회사명 또는 종목코드>",
+	Short: "공모·사모자금의 계획 대비 실제 사용내역을 조회합니다",
+	Long: `최근 N개 사업보고서의 공모자금·사모자금 사용내역을 회차별로 모아
+신고서(주요사항보고서)상 자금사용 계획과 실제 사용내역을 나란히 보여줍니다.
+
+용도는 시설자금·영업양수자금·운영자금·채무상환자금·타법인증권 취득자금·
+기타자금으로 묶어 비교하며, 계획보다 덜 쓴 용도와 더 쓴 용도를 짝지어
+용도 전용 의심으로 표시합니다. 시설자금 → 운영자금 전용은 ⚠로 강조합니다.
+같은 회차가 여러 해 보고서에 나오면 가장 최근 보고서를 사용합니다.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		corpCode, corpName, err := resolveCorpCode(args[0])
+		if err != nil {
+			return err
+		}
+
+		n := proceedsYears
+		if n <= 0 {
+			n = 3
+		}
+		latest := time.Now().Year() - 1
+
+		client := api.New(cfg.APIKey)
+		var sources []render.ProceedsSource
+		for year := latest; year > latest-n; year-- {
+			opts := api.ReportOptions{
+				CorpCode:  corpCode,
+				BsnsYear:  strconv.Itoa(year),
+				ReprtCode: api.ReprtCode("annual"),
+			}
+			src := render.ProceedsSource{Year: year}
+			if src.Public, err = client.GetPublicFundUsage(opts); err != nil {
+				return fmt.Errorf("%d년 공모자금 사용내역 조회 실패: %w", year, err)
+			}
+			if src.Private, err = client.GetPrivateFundUsage(opts); err != nil {
+				return fmt.Errorf("%d년 사모자금 사용내역 조회 실패: %w", year, err)
+			}
+			sources = append(sources, src)
+		}
+
+		rounds := render.FundingRounds(sources)
+		if len(rounds) == 0 {
+			return printEmpty([]render.FundingRound{}, "%s: 최근 %d년간 공모·사모자금 사용내역이 없습니다.", corpName, n)
+		}
+
+		return renderer.OutputWide(rounds, func() string {
+			return render.ProceedsMarkdown(corpName, n, rounds)
+		})
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(proceedsCmd)
+	proceedsCmd.Flags().IntVar(&proceedsYears, "years", 3, "최근 N개 사업연도")
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/seapy/dartcli/internal/api"
@@ -10,6 +11,7 @@ import (
 	"github.com/seapy/dartcli/internal/config"
 	"github.com/seapy/dartcli/internal/render"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
 
@@ -18,6 +20,10 @@ var (
 	apiKey  string
 	noColor bool
 	style   string
+	format  string
+	outFile string
+	tmplArg string
+	rawMD   bool
 
 	cfg      *config.Config
 	apiClient *api.Client
@@ -50,6 +56,26 @@ API 키 설정:
   DART_API_KEY 환경변수  스크립트/CI 환경`,
 	SilenceUsage:  true,
 	SilenceErrors: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if rawMD && format != render.FormatText && format != render.FormatMarkdown {
+			return fmt.Errorf("--raw 와 --format %s 는 함께 사용할 수 없습니다", format)
+		}
+		if !render.ValidFormat(format) {
+			return fmt.Errorf("알 수 없는 출력 형식: %s (%s)", format, strings.Join(render.Formats, "|"))
+		}
+		if tmplArg != "" {
+			if format != render.FormatText {
+				return fmt.Errorf("--template 과 --format %s 는 함께 사용할 수 없습니다", format)
+			}
+			t, err := render.LoadTemplate(tmplArg, config.TemplateDir)
+			if err != nil {
+				return err
+			}
+			renderer.SetTemplate(t)
+		}
+		recordQuery(cmd, args)
+		return nil
+	},
 }
 
 // Execute is the entry point called from main.
@@ -67,6 +93,10 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&apiKey, "api-key", "", "DART API 키")
 	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "색상 비활성화")
 	rootCmd.PersistentFlags().StringVar(&style, "style", "auto", "Glamour 스타일 (auto|dark|light|notty)")
+	rootCmd.PersistentFlags().StringVar(&format, "format", render.FormatText, "출력 형식 (text|markdown|json|yaml|csv|tsv|xlsx)")
+	rootCmd.PersistentFlags().StringVarP(&outFile, "output", "o", "", "결과를 저장할 파일 경로 (기본: 표준출력)")
+	rootCmd.PersistentFlags().BoolVar(&rawMD, "raw", false, "마크다운 원문 출력 (--format markdown 과 같음)")
+	rootCmd.PersistentFlags().StringVar(&tmplArg, "template", "", "Go text/template 파일, ~/.dartcli/templates 의 이름 또는 인라인 템플릿")
 
 	viper.BindPFlag("api_key", rootCmd.PersistentFlags().Lookup("api-key"))
 }
@@ -86,7 +116,11 @@ func initConfig() {
 		cfg.Style = style
 	}
 
-	renderer = render.New(cfg.Style, noColor)
+	if rawMD && format == render.FormatText {
+		format = render.FormatMarkdown
+	}
+	renderer = render.New(cfg.Style, noColor, format)
+	renderer.SetOutputFile(outFile)
 }
 
 // requireAPIKey ensures an API key is available, printing a helpful message if not.
@@ -137,18 +171,55 @@ func resolveCorpCode(query string) (string, string, error) {
 	if len(results) == 0 {
 		return "", "", fmt.Errorf("기업을 찾을 수 없습니다: %q", query)
 	}
-	if len(results) == 1 {
-		return results[0].CorpCode, results[0].CorpName, nil
+	corpCode, corpName := results[0].CorpCode, results[0].CorpName
+	if len(results) > 1 {
+		// Multiple results → interactive selection
+		var err error
+		if corpCode, corpName, err = selectCorp(results); err != nil {
+			return "", "", err
+		}
 	}
 
-	// Multiple results → interactive selection
-	return selectCorp(results)
+	meta := renderer.Meta()
+	meta.CorpCode, meta.CorpName = corpCode, corpName
+	return corpCode, corpName, nil
+}
+
+// recordQuery fills the markdown front matter with the command line. Every
+// command-specific flag is recorded, defaults included, so the document
+// says exactly which query produced it; global flags are left out.
+func recordQuery(cmd *cobra.Command, args []string) {
+	meta := renderer.Meta()
+	meta.Command = cmd.CommandPath()
+	meta.Args = args
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if f.Name == "help" || cmd.Root().PersistentFlags().Lookup(f.Name) != nil {
+			return
+		}
+		if meta.Params == nil {
+			meta.Params = map[string]string{}
+		}
+		meta.Params[f.Name] = f.Value.String()
+	})
 }
 
 func printError(err error) {
 	fmt.Fprintln(os.Stderr, errStyle.Render("오류: "+err.Error()))
 }
 
+// printEmpty reports an empty result. Text output gets the message;
+// --format json|yaml gets the empty value v so scripts still parse it.
+// Markdown and -o still go through the renderer, so front matter and the
+// output file are written as for a non-empty result.
+func printEmpty(v any, msg string, args ...any) error {
+	text := fmt.Sprintf(msg, args...)
+	if format != render.FormatText || outFile != "" || renderer.Machine() {
+		return renderer.Output(v, func() string { return text + "\n" })
+	}
+	fmt.Println(text)
+	return nil
+}
+
 func printWarning(msg string) {
 	fmt.Fprintln(os.Stderr, warnStyle.Render(msg))
 }
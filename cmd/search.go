@@ -1,9 +1,7 @@
 package cmd
 
 import (
-	"fmt"
-	"strings"
-
+	"github.com/seapy/dartcli/internal/render"
 	"github.com/spf13/cobra"
 )
 
@@ -20,26 +18,12 @@ var searchCmd = &cobra.Command{
 		results := corpStore.Search(query)
 
 		if len(results) == 0 {
-			fmt.Printf("검색 결과 없음: %q\n", query)
-			return nil
-		}
-
-		var sb strings.Builder
-		fmt.Fprintf(&sb, "# 검색 결과: %q\n\n", query)
-		fmt.Fprintf(&sb, "총 **%d**건\n\n", len(results))
-		sb.WriteString("| 기업명 | 종목코드 | Corp Code | 수정일 |\n")
-		sb.WriteString("|--------|----------|-----------|--------|\n")
-
-		for _, r := range results {
-			stock := r.StockCode
-			if stock == "" {
-				stock = "-"
-			}
-			fmt.Fprintf(&sb, "| %s | %s | `%s` | %s |\n",
-				r.CorpName, stock, r.CorpCode, r.ModifyDate)
+			return printEmpty(render.CorpList{}, "검색 결과 없음: %q", query)
 		}
 
-		return renderer.Print(sb.String())
+		return renderer.Output(render.CorpList(results), func() string {
+			return render.SearchMarkdown(query, results)
+		})
 	},
 }
 
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/render"
+	"github.com/spf13/cobra"
+)
+
+var (
+	tablesOutput string
+	tablesMatch  string
+	tablesFile   string
+	tablesAll    bool
+	tablesUnit   string
+)
+
+// tablesManifestFile is written next to the table CSVs.
+const tablesManifestFile = "manifest.csv"
+
+var tablesCmd = &cobra.Command{
+	Use:   "tables <접수번호>",
+	Short: "공시 원문의 표를 CSV 파일로 추출합니다",
+	Long: `공시 원문의 표를 하나씩 CSV 파일로 저장합니다.
+
+파일 이름은 표 번호와 섹션 경로로 정해지며(예: 012_II._사업의_내용_2._주요_제품.csv),
+병합 셀은 펼치고 여러 줄 헤더는 "당기 / 금액"처럼 합칩니다. 숫자 셀은 쉼표 없이
+숫자로 저장합니다. 표 번호, 파일, 섹션, 캡션, 단위, 크기를 담은 manifest.csv 를
+함께 저장하고 같은 목록을 출력합니다.
+
+  dartcli tables <접수번호>                     # ./<접수번호>_tables 에 저장
+  dartcli tables <접수번호> -o out --match 요약재무
+  dartcli tables <접수번호> --unit 억원 --format json
+
+--match 는 표 바로 위의 제목·캡션 또는 섹션 경로에 포함된 표만 저장합니다
+(대소문자·띄어쓰기 무시). --file, --all-files 는 view 와 같이 ZIP의 파일을
+고릅니다.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		rceptNo := args[0]
+		renderer.Meta().RceptNo = rceptNo
+
+		if tablesFile != "" && tablesAll {
+			return fmt.Errorf("--file 과 --all-files 는 함께 사용할 수 없습니다")
+		}
+		var unit *render.Unit
+		if tablesUnit != "" {
+			u, err := render.TargetUnit(tablesUnit)
+			if err != nil {
+				return err
+			}
+			unit = u
+		}
+
+		if err := requireAPIKey(); err != nil {
+			return err
+		}
+
+		docs, err := fetchDocuments(api.New(cfg.APIKey), rceptNo, render.FileSelection{All: tablesAll, File: tablesFile})
+		if err != nil {
+			return err
+		}
+		if unit != nil {
+			for _, d := range docs {
+				d.ConvertUnits(unit)
+			}
+		}
+
+		var manifest render.TableManifest
+		for _, t := range render.ExtractTables(docs) {
+			if tablesMatch == "" || t.Matches(tablesMatch) {
+				manifest = append(manifest, t)
+			}
+		}
+		if len(manifest) == 0 {
+			if tablesMatch != "" {
+				return printEmpty(manifest, "'%s'에 해당하는 표가 없습니다.", tablesMatch)
+			}
+			return printEmpty(manifest, "추출할 표가 없습니다.")
+		}
+
+		dir := tablesOutput
+		if dir == "" {
+			dir = rceptNo + "_tables"
+		}
+		if err := writeTables(dir, manifest); err != nil {
+			return err
+		}
+		dir, _ = filepath.Abs(dir)
+		return renderer.Output(manifest, func() string {
+			return render.TableManifestMarkdown(rceptNo, dir, manifest)
+		})
+	},
+}
+
+// writeTables writes each table to its own CSV in dir, followed by the
+// manifest.
+func writeTables(dir string, manifest render.TableManifest) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("디렉터리 생성 실패: %w", err)
+	}
+	write := func(name string, tables []render.Table) error {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			return fmt.Errorf("파일 저장 실패: %w", err)
+		}
+		if err := render.WriteDelimited(f, tables, ','); err != nil {
+			f.Close()
+			return fmt.Errorf("파일 저장 실패: %w", err)
+		}
+		return f.Close()
+	}
+	for _, t := range manifest {
+		if err := write(t.File, []render.Table{t.Export()}); err != nil {
+			return err
+		}
+	}
+	return write(tablesManifestFile, manifest.Tables())
+}
+
+func init() {
+	rootCmd.AddCommand(tablesCmd)
+	tablesCmd.Flags().StringVarP(&tablesOutput, "output", "o", "", "저장 디렉터리 (기본: ./<접수번호>_tables)")
+	tablesCmd.Flags().StringVar(&tablesMatch, "match", "", "제목·캡션 또는 섹션 경로에 이 문자열이 포함된 표만 저장")
+	tablesCmd.Flags().StringVar(&tablesFile, "file", "", "추출할 파일 (view --list-files 의 번호, 파일 이름 또는 문서 제목)")
+	tablesCmd.Flags().BoolVar(&tablesAll, "all-files", false, "ZIP의 모든 파일에서 추출")
+	tablesCmd.Flags().StringVar(&tablesUnit, "unit", "", "금액 표 환산 단위 ("+strings.Join(render.TargetUnits, ", ")+")")
+}
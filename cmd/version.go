@@ -10,9 +10,17 @@ import (
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "버전 정보를 출력합니다",
-	Run: func(cmd *cobra.Command, args []string) {
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if renderer.Machine() {
+			return renderer.Output(map[string]string{
+				"version":    dartcli.Version,
+				"commit":     dartcli.Commit,
+				"build_date": dartcli.BuildDate,
+			}, nil)
+		}
 		fmt.Printf("dartcli %s (commit: %s, built: %s)\n",
 			dartcli.Version, dartcli.Commit, dartcli.BuildDate)
+		return nil
 	},
 }
 
@@ -4,10 +4,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
+	"github.com/mattn/go-isatty"
 	"github.com/pkg/browser"
 	"github.com/seapy/dartcli/internal/api"
 	"github.com/seapy/dartcli/internal/render"
+	"github.com/seapy/dartcli/internal/tui"
 	"github.com/spf13/cobra"
 )
 
@@ -15,9 +18,32 @@ var (
 	viewBrowser  bool
 	viewDownload bool
 	viewOutput   string
+	viewTOC      bool
+	viewSection  string
+	viewTUI      bool
+	viewUnit     string
+	viewList     bool
+	viewFile     string
+	viewAll      bool
 )
 
-const dartViewBaseURL = "https://dart.fss.or.kr/dsaf001/main.do?rcpNo="
+// viewData is the --format json|yaml payload: the parsed document tree
+// of each file in the filing. Its tables export as csv, tsv and xlsx.
+type viewData struct {
+	RceptNo   string           `json:"rcept_no"`
+	URL       string           `json:"url"`
+	Documents render.Documents `json:"documents"`
+}
+
+// Tables implements render.Tabular.
+func (v viewData) Tables() []render.Table { return v.Documents.Tables() }
+
+// tocData is the --toc payload for --format json|yaml.
+type tocData struct {
+	Name  string            `json:"name,omitempty"`
+	Title string            `json:"title,omitempty"`
+	TOC   []render.TOCEntry `json:"toc"`
+}
 
 var viewCmd = &cobra.Command{
 	Use:   "view <접수번호>",
@@ -32,20 +58,50 @@ DART XML 원문을 파싱하여 다음 구조로 출력합니다.
   - 재무제표·통계 데이터      → 마크다운 표
   - 본문 서술                 → 단락 텍스트
 
-사업보고서 기준 수천 줄 분량이므로 파이프로 페이저를 연결하거나
-grep으로 원하는 섹션만 필터링하는 것을 권장합니다.
-  dartcli view <접수번호> | less -R
-  dartcli view <접수번호> | grep -A 30 "사업의 개요"
+사업보고서 기준 수천 줄 분량이므로 --toc로 목차를 확인한 뒤
+--section으로 필요한 섹션만 출력하는 것을 권장합니다.
+  dartcli view <접수번호> --toc
+  dartcli view <접수번호> --section II
+  dartcli view <접수번호> --section "사업의 내용/주요 제품"
+  dartcli view <접수번호> --section 2.2
+
+--section 은 --toc 에 표시된 번호(2.2) 또는 "/"로 구분한 섹션 경로를
+받습니다. 경로의 각 부분은 제목 앞 번호(II, 2., 가.)나 제목 일부이며,
+일치하는 섹션과 그 하위 섹션 전체를 출력합니다.
+
+--format json|yaml 을 지정하면 표지, 목차, 섹션 트리(섹션 경로 포함),
+단락과 표(행·셀)로 구성된 문서 구조를 출력합니다.
+  dartcli view <접수번호> --format json | jq '.documents[0].toc'
+
+공시 ZIP에는 본문 외에 감사보고서·첨부서류가 함께 들어 있습니다. 기본으로는
+본문과 큰 파일 순으로 3개까지 출력하며, --list-files 로 전체 목록을 보고
+--file 로 특정 파일을, --all-files 로 모든 파일을 출력할 수 있습니다.
+  dartcli view <접수번호> --list-files
+  dartcli view <접수번호> --file 2
+  dartcli view <접수번호> --file 감사보고서
+
+표의 단위는 "(단위 : 백만원)" 같은 캡션에서 인식합니다. --unit 을 지정하면
+금액 표를 해당 단위로 환산합니다 (비율·주식수 등은 그대로 둡니다).
+  dartcli view <접수번호> --section "매출" --unit 억원
+--format csv|tsv|xlsx 는 문서의 표를 숫자 값 그대로 내보냅니다.
+  dartcli view <접수번호> --format xlsx -o report.xlsx
+
+--tui 는 전체 화면 리더를 엽니다. 왼쪽 목차 트리에서 섹션을 펼치고
+(space/←/→) 열며(enter), 오른쪽 본문을 스크롤합니다.
+  /  검색   n/N  다음·이전 결과   t/T  다음·이전 표
+  c  현재 섹션을 마크다운으로 클립보드에 복사   tab  목차↔본문   q  종료
 
 출력 옵션:
+  --tui      전체 화면 리더로 읽기
   --browser  DART 웹사이트에서 브라우저로 열기
   --download ZIP 원문 파일로 저장`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		rceptNo := args[0]
+		renderer.Meta().RceptNo = rceptNo
 
 		if viewBrowser {
-			url := dartViewBaseURL + rceptNo
+			url := render.DARTViewURL(rceptNo)
 			fmt.Printf("브라우저에서 열기: %s\n", url)
 			if err := browser.OpenURL(url); err != nil {
 				return fmt.Errorf("브라우저 열기 실패: %w", err)
@@ -53,6 +109,28 @@ grep으로 원하는 섹션만 필터링하는 것을 권장합니다.
 			return nil
 		}
 
+		if viewFile != "" && viewAll {
+			return fmt.Errorf("--file 과 --all-files 는 함께 사용할 수 없습니다")
+		}
+
+		if viewTUI {
+			if viewTOC || viewList || viewDownload || renderer.Machine() || viewOutput != "" {
+				return fmt.Errorf("--tui 는 --toc, --list-files, --download, -o, --format, --template 과 함께 사용할 수 없습니다")
+			}
+			if !isatty.IsTerminal(os.Stdout.Fd()) {
+				return fmt.Errorf("--tui 는 터미널에서만 사용할 수 있습니다")
+			}
+		}
+
+		var unit *render.Unit
+		if viewUnit != "" {
+			u, err := render.TargetUnit(viewUnit)
+			if err != nil {
+				return err
+			}
+			unit = u
+		}
+
 		if err := requireAPIKey(); err != nil {
 			return err
 		}
@@ -76,12 +154,60 @@ grep으로 원하는 섹션만 필터링하는 것을 권장합니다.
 			return nil
 		}
 
+		renderer.SetOutputFile(viewOutput)
+
 		// Default: render in terminal
-		md, err := render.DocumentFromZIP(data, rceptNo)
+		if viewList {
+			files, err := render.ListDocumentFiles(data, rceptNo)
+			if err != nil {
+				return fmt.Errorf("문서 렌더링 실패: %w", err)
+			}
+			return renderer.Output(files, func() string {
+				return render.DocumentFilesMarkdown(rceptNo, files)
+			})
+		}
+
+		docs, err := render.ParseDocumentZIP(data, rceptNo, render.FileSelection{All: viewAll, File: viewFile})
 		if err != nil {
 			return fmt.Errorf("문서 렌더링 실패: %w", err)
 		}
-		return renderer.PrintWide(md)
+		warnRecoveries(docs)
+		if viewTOC {
+			var toc []tocData
+			for _, d := range docs {
+				toc = append(toc, tocData{d.Name, d.Title, d.TOC})
+			}
+			return renderer.Output(toc, func() string {
+				return render.TOCMarkdown(docs)
+			})
+		}
+
+		if viewSection != "" {
+			if docs = selectSections(docs, viewSection); len(docs) == 0 {
+				return fmt.Errorf("섹션을 찾을 수 없습니다: %s (--toc로 목차를 확인하세요)", viewSection)
+			}
+		}
+
+		if unit != nil {
+			for _, d := range docs {
+				d.ConvertUnits(unit)
+			}
+		}
+
+		if viewTUI {
+			title := rceptNo
+			if d := docs[0]; d.Title != "" {
+				title = fmt.Sprintf("%s (%s)", d.Title, rceptNo)
+				if d.Company != "" {
+					title = d.Company + " · " + title
+				}
+			}
+			return tui.Run(title, docs, renderer.ResolvedStyle())
+		}
+
+		return renderer.OutputWide(viewData{rceptNo, render.DARTViewURL(rceptNo), docs}, func() string {
+			return render.DocumentsMarkdown(docs)
+		})
 	},
 }
 
@@ -89,5 +215,61 @@ func init() {
 	rootCmd.AddCommand(viewCmd)
 	viewCmd.Flags().BoolVar(&viewBrowser, "browser", false, "브라우저로 열기")
 	viewCmd.Flags().BoolVar(&viewDownload, "download", false, "ZIP 파일로 저장")
-	viewCmd.Flags().StringVarP(&viewOutput, "output", "o", "", "저장 경로 (--download 와 함께 사용)")
+	viewCmd.Flags().BoolVar(&viewTUI, "tui", false, "전체 화면 리더로 읽기 (목차 트리, 검색, 표 이동, 섹션 복사)")
+	viewCmd.Flags().BoolVar(&viewList, "list-files", false, "ZIP에 든 파일 목록(문서 제목 포함) 출력")
+	viewCmd.Flags().StringVar(&viewFile, "file", "", "출력할 파일 (--list-files 의 번호, 파일 이름 또는 문서 제목)")
+	viewCmd.Flags().BoolVar(&viewAll, "all-files", false, "ZIP의 모든 파일을 순서대로 출력")
+	viewCmd.Flags().StringVar(&viewUnit, "unit", "", "금액 표 환산 단위 ("+strings.Join(render.TargetUnits, ", ")+")")
+	viewCmd.Flags().BoolVar(&viewTOC, "toc", false, "목차(섹션 구조)만 출력")
+	viewCmd.Flags().StringVar(&viewSection, "section", "", "출력할 섹션 (목차 번호 또는 경로, 예: II, \"사업의 내용/주요 제품\", 2.2)")
+	viewCmd.Flags().StringVarP(&viewOutput, "output", "o", "", "저장 경로 (--download 시 ZIP, 그 외 --format 출력)")
+}
+
+// fetchDocuments downloads a filing and parses the selected files.
+func fetchDocuments(client *api.Client, rceptNo string, sel render.FileSelection) ([]*render.Document, error) {
+	data, err := client.GetDocumentZIP(rceptNo)
+	if err != nil {
+		return nil, fmt.Errorf("문서 다운로드 실패 (%s): %w", rceptNo, err)
+	}
+	docs, err := render.ParseDocumentZIP(data, rceptNo, sel)
+	if err != nil {
+		return nil, fmt.Errorf("문서 렌더링 실패 (%s): %w", rceptNo, err)
+	}
+	warnRecoveries(docs)
+	return docs, nil
+}
+
+// maxRecoveryWarnings caps how many skipped fragments are listed per file.
+const maxRecoveryWarnings = 5
+
+// warnRecoveries reports the malformed XML fragments the parser skipped,
+// so a report that looks cut short can be checked against the original.
+func warnRecoveries(docs []*render.Document) {
+	for _, d := range docs {
+		if len(d.Warnings) == 0 {
+			continue
+		}
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "%s: 잘못된 XML %d곳을 건너뛰고 계속 읽었습니다", d.Name, len(d.Warnings))
+		for i, w := range d.Warnings {
+			if i == maxRecoveryWarnings {
+				fmt.Fprintf(&sb, "\n  … 외 %d곳", len(d.Warnings)-i)
+				break
+			}
+			fmt.Fprintf(&sb, "\n  %d바이트: %s", w.Offset, w.Message)
+		}
+		printWarning(sb.String())
+	}
+}
+
+// selectSections narrows each file to the sections matching spec,
+// dropping files where nothing matches.
+func selectSections(docs []*render.Document, spec string) []*render.Document {
+	var selected []*render.Document
+	for _, d := range docs {
+		if sel := d.Select(spec); sel != nil {
+			selected = append(selected, sel)
+		}
+	}
+	return selected
 }
@@ -0,0 +1,16 @@
+package api
+
+// GetAuditOpinion fetches 회계감사인의 명칭 및 감사의견.
+func (c *Client) GetAuditOpinion(opts ReportOptions) ([]AuditOpinion, error) {
+	return getReport[AuditOpinion](c, "/api/accnutAdtorNmNdAdtOpinion.json", opts)
+}
+
+// GetAuditServiceContract fetches 감사용역체결현황.
+func (c *Client) GetAuditServiceContract(opts ReportOptions) ([]AuditServiceContract, error) {
+	return getReport[AuditServiceContract](c, "/api/adtServcCnclsSttus.json", opts)
+}
+
+// GetNonAuditServiceContract fetches 회계감사인과의 비감사용역 계약체결 현황.
+func (c *Client) GetNonAuditServiceContract(opts ReportOptions) ([]NonAuditServiceContract, error) {
+	return getReport[NonAuditServiceContract](c, "/api/accnutAdtorNonAdtServcCnclsSttus.json", opts)
+}
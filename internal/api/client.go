@@ -89,3 +89,25 @@ func checkStatus(base BaseResponse) error {
 	}
 	return nil
 }
+
+// listResponse is the envelope shared by endpoints that return a "list" array.
+type listResponse[T any] struct {
+	BaseResponse
+	Items []T `json:"list"`
+}
+
+// getList performs a GET request against an endpoint returning a "list" array.
+// Status 013 (조회된 데이터 없음) is treated as an empty result.
+func getList[T any](c *Client, path string, params url.Values) ([]T, error) {
+	var result listResponse[T]
+	if err := c.get(path, params, &result); err != nil {
+		return nil, err
+	}
+	if result.Status == "013" {
+		return nil, nil
+	}
+	if err := checkStatus(result.BaseResponse); err != nil {
+		return nil, err
+	}
+	return result.Items, nil
+}
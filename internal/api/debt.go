@@ -0,0 +1,31 @@
+package api
+
+// GetBondBalance fetches 회사채 미상환 잔액.
+func (c *Client) GetBondBalance(opts ReportOptions) ([]BondBalance, error) {
+	return getReport[BondBalance](c, "/api/cprndNrdmpBlce.json", opts)
+}
+
+// GetCommercialPaperBalance fetches 기업어음증권 미상환 잔액.
+func (c *Client) GetCommercialPaperBalance(opts ReportOptions) ([]CommercialPaperBalance, error) {
+	return getReport[CommercialPaperBalance](c, "/api/entrprsBilScritsNrdmpBlce.json", opts)
+}
+
+// GetShortTermBondBalance fetches 단기사채 미상환 잔액.
+func (c *Client) GetShortTermBondBalance(opts ReportOptions) ([]ShortTermBondBalance, error) {
+	return getReport[ShortTermBondBalance](c, "/api/srtpdPsndbtNrdmpBlce.json", opts)
+}
+
+// GetHybridCapitalBalance fetches 신종자본증권 미상환 잔액.
+func (c *Client) GetHybridCapitalBalance(opts ReportOptions) ([]HybridCapitalBalance, error) {
+	return getReport[HybridCapitalBalance](c, "/api/newCaplScritsNrdmpBlce.json", opts)
+}
+
+// GetContingentCapitalBalance fetches 조건부자본증권 미상환 잔액.
+func (c *Client) GetContingentCapitalBalance(opts ReportOptions) ([]ContingentCapitalBalance, error) {
+	return getReport[ContingentCapitalBalance](c, "/api/cndlCaplScritsNrdmpBlce.json", opts)
+}
+
+// GetDebtIssuance fetches 채무증권 발행실적.
+func (c *Client) GetDebtIssuance(opts ReportOptions) ([]DebtIssuance, error) {
+	return getReport[DebtIssuance](c, "/api/detScritsIsuAcmslt.json", opts)
+}
@@ -0,0 +1,135 @@
+package api
+
+import "net/url"
+
+// EventOptions configures 주요사항보고서 주요정보 queries, which all share the
+// corp_code / bgn_de / end_de parameter set.
+type EventOptions struct {
+	CorpCode  string
+	StartDate string // YYYYMMDD
+	EndDate   string // YYYYMMDD
+}
+
+// getEvent fetches one 주요사항보고서 주요정보 endpoint.
+func getEvent[T any](c *Client, path string, opts EventOptions) ([]T, error) {
+	params := url.Values{}
+	params.Set("corp_code", opts.CorpCode)
+	params.Set("bgn_de", opts.StartDate)
+	params.Set("end_de", opts.EndDate)
+	return getList[T](c, path, params)
+}
+
+// GetConvertibleBondDecisions fetches 전환사채권 발행결정.
+func (c *Client) GetConvertibleBondDecisions(opts EventOptions) ([]ConvertibleBondDecision, error) {
+	return getEvent[ConvertibleBondDecision](c, "/api/cvbdIsDecsn.json", opts)
+}
+
+// GetWarrantBondDecisions fetches 신주인수권부사채권 발행결정.
+func (c *Client) GetWarrantBondDecisions(opts EventOptions) ([]WarrantBondDecision, error) {
+	return getEvent[WarrantBondDecision](c, "/api/bdwtIsDecsn.json", opts)
+}
+
+// GetExchangeableBondDecisions fetches 교환사채권 발행결정.
+func (c *Client) GetExchangeableBondDecisions(opts EventOptions) ([]ExchangeableBondDecision, error) {
+	return getEvent[ExchangeableBondDecision](c, "/api/exbdIsDecsn.json", opts)
+}
+
+// GetMergerDecisions fetches 회사합병 결정.
+func (c *Client) GetMergerDecisions(opts EventOptions) ([]MergerDecision, error) {
+	return getEvent[MergerDecision](c, "/api/cmpMgDecsn.json", opts)
+}
+
+// GetSplitDecisions fetches 회사분할 결정.
+func (c *Client) GetSplitDecisions(opts EventOptions) ([]SplitDecision, error) {
+	return getEvent[SplitDecision](c, "/api/cmpDvDecsn.json", opts)
+}
+
+// GetSplitMergerDecisions fetches 회사분할합병 결정.
+func (c *Client) GetSplitMergerDecisions(opts EventOptions) ([]SplitMergerDecision, error) {
+	return getEvent[SplitMergerDecision](c, "/api/cmpDvmgDecsn.json", opts)
+}
+
+// GetShareExchangeDecisions fetches 주식교환·이전 결정.
+func (c *Client) GetShareExchangeDecisions(opts EventOptions) ([]ShareExchangeDecision, error) {
+	return getEvent[ShareExchangeDecision](c, "/api/stkExtrDecsn.json", opts)
+}
+
+// GetBusinessAcquisitionDecisions fetches 영업양수 결정.
+func (c *Client) GetBusinessAcquisitionDecisions(opts EventOptions) ([]BusinessTransferDecision, error) {
+	return getEvent[BusinessTransferDecision](c, "/api/bsnInhDecsn.json", opts)
+}
+
+// GetBusinessTransferDecisions fetches 영업양도 결정.
+func (c *Client) GetBusinessTransferDecisions(opts EventOptions) ([]BusinessTransferDecision, error) {
+	return getEvent[BusinessTransferDecision](c, "/api/bsnTrfDecsn.json", opts)
+}
+
+// GetAssetAcquisitionDecisions fetches 유형자산 양수 결정.
+func (c *Client) GetAssetAcquisitionDecisions(opts EventOptions) ([]AssetTransferDecision, error) {
+	return getEvent[AssetTransferDecision](c, "/api/tgastInhDecsn.json", opts)
+}
+
+// GetAssetTransferDecisions fetches 유형자산 양도 결정.
+func (c *Client) GetAssetTransferDecisions(opts EventOptions) ([]AssetTransferDecision, error) {
+	return getEvent[AssetTransferDecision](c, "/api/tgastTrfDecsn.json", opts)
+}
+
+// GetStockAcquisitionDecisions fetches 타법인 주식 및 출자증권 양수결정.
+func (c *Client) GetStockAcquisitionDecisions(opts EventOptions) ([]StockTransferDecision, error) {
+	return getEvent[StockTransferDecision](c, "/api/otcprStkInvscrInhDecsn.json", opts)
+}
+
+// GetStockTransferDecisions fetches 타법인 주식 및 출자증권 양도결정.
+func (c *Client) GetStockTransferDecisions(opts EventOptions) ([]StockTransferDecision, error) {
+	return getEvent[StockTransferDecision](c, "/api/otcprStkInvscrTrfDecsn.json", opts)
+}
+
+// GetDefaultOccurrences fetches 부도발생.
+func (c *Client) GetDefaultOccurrences(opts EventOptions) ([]DefaultOccurrence, error) {
+	return getEvent[DefaultOccurrence](c, "/api/dfOcr.json", opts)
+}
+
+// GetBusinessSuspensions fetches 영업정지.
+func (c *Client) GetBusinessSuspensions(opts EventOptions) ([]BusinessSuspension, error) {
+	return getEvent[BusinessSuspension](c, "/api/bsnSp.json", opts)
+}
+
+// GetRehabilitationFilings fetches 회생절차 개시신청.
+func (c *Client) GetRehabilitationFilings(opts EventOptions) ([]RehabilitationFiling, error) {
+	return getEvent[RehabilitationFiling](c, "/api/ctrcvsBgrq.json", opts)
+}
+
+// GetDissolutionCauses fetches 해산사유 발생.
+func (c *Client) GetDissolutionCauses(opts EventOptions) ([]DissolutionCause, error) {
+	return getEvent[DissolutionCause](c, "/api/dsRsOcr.json", opts)
+}
+
+// GetCreditorManagements fetches 채권은행 등의 관리절차 개시.
+func (c *Client) GetCreditorManagements(opts EventOptions) ([]CreditorManagement, error) {
+	return getEvent[CreditorManagement](c, "/api/bnkMngtPcbg.json", opts)
+}
+
+// GetPaidInCapitalDecisions fetches 유상증자 결정.
+func (c *Client) GetPaidInCapitalDecisions(opts EventOptions) ([]PaidInCapitalDecision, error) {
+	return getEvent[PaidInCapitalDecision](c, "/api/piicDecsn.json", opts)
+}
+
+// GetBonusIssueDecisions fetches 무상증자 결정.
+func (c *Client) GetBonusIssueDecisions(opts EventOptions) ([]BonusIssueDecision, error) {
+	return getEvent[BonusIssueDecision](c, "/api/fricDecsn.json", opts)
+}
+
+// GetMixedCapitalDecisions fetches 유무상증자 결정.
+func (c *Client) GetMixedCapitalDecisions(opts EventOptions) ([]MixedCapitalDecision, error) {
+	return getEvent[MixedCapitalDecision](c, "/api/pifricDecsn.json", opts)
+}
+
+// GetCapitalReductionDecisions fetches 감자 결정.
+func (c *Client) GetCapitalReductionDecisions(opts EventOptions) ([]CapitalReductionDecision, error) {
+	return getEvent[CapitalReductionDecision](c, "/api/crDecsn.json", opts)
+}
+
+// GetLawsuitFilings fetches 소송 등의 제기.
+func (c *Client) GetLawsuitFilings(opts EventOptions) ([]LawsuitFiling, error) {
+	return getEvent[LawsuitFiling](c, "/api/lwstLg.json", opts)
+}
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
 // FinanceOptions configures the single account query.
@@ -105,3 +108,33 @@ func (c *Client) GetFinanceMultiAccount(opts FinanceOptions) (*FinanceResponse,
 	}
 	return &result, nil
 }
+
+// MarshalJSON encodes amounts and ord as numbers (null when blank) so
+// --format json|yaml output is typed. Decoding still uses the raw strings.
+func (a FinanceAccount) MarshalJSON() ([]byte, error) {
+	type raw FinanceAccount
+	return json.Marshal(struct {
+		raw
+		Thstrm_amount    *int64 `json:"thstrm_amount"`
+		Frmtrm_amount    *int64 `json:"frmtrm_amount"`
+		Bfefrmtrm_amount *int64 `json:"bfefrmtrm_amount"`
+		OrdNo            *int64 `json:"ord"`
+	}{
+		raw:              raw(a),
+		Thstrm_amount:    amountValue(a.Thstrm_amount),
+		Frmtrm_amount:    amountValue(a.Frmtrm_amount),
+		Bfefrmtrm_amount: amountValue(a.Bfefrmtrm_amount),
+		OrdNo:            amountValue(a.OrdNo),
+	})
+}
+
+// amountValue parses a DART amount such as "1,234" or "-56", returning nil
+// for blanks and "-".
+func amountValue(s string) *int64 {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
@@ -7,23 +7,34 @@ import (
 
 // ListOptions configures the disclosure list query.
 type ListOptions struct {
-	CorpCode  string
-	StartDate string // YYYYMMDD
-	EndDate   string // YYYYMMDD
-	PblntfTy  string // disclosure type code
-	PageNo    int
-	PageCount int
+	CorpCode       string
+	StartDate      string // YYYYMMDD
+	EndDate        string // YYYYMMDD
+	PblntfTy       string // disclosure type code
+	PblntfDetailTy string // detailed disclosure type code (e.g. B001)
+	CorpCls        string // Y=유가, K=코스닥, N=코넥스, E=기타
+	PageNo         int
+	PageCount      int
 }
 
 // GetList fetches the disclosure list for the given options.
 func (c *Client) GetList(opts ListOptions) (*ListResponse, error) {
 	params := url.Values{}
-	params.Set("corp_code", opts.CorpCode)
+	// Without corp_code DART searches the whole market (max 3-month range).
+	if opts.CorpCode != "" {
+		params.Set("corp_code", opts.CorpCode)
+	}
 	params.Set("bgn_de", opts.StartDate)
 	params.Set("end_de", opts.EndDate)
 	if opts.PblntfTy != "" {
 		params.Set("pblntf_ty", opts.PblntfTy)
 	}
+	if opts.PblntfDetailTy != "" {
+		params.Set("pblntf_detail_ty", opts.PblntfDetailTy)
+	}
+	if opts.CorpCls != "" {
+		params.Set("corp_cls", opts.CorpCls)
+	}
 	if opts.PageNo > 0 {
 		params.Set("page_no", fmt.Sprintf("%d", opts.PageNo))
 	}
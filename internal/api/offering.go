@@ -0,0 +1,123 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// Field is one key/value pair of a 증권신고서 group row.
+type Field struct {
+	Key   string
+	Value string
+}
+
+// Fields is a group row that keeps DART's field order, which the
+// registration endpoints use as their presentation order.
+type Fields []Field
+
+// UnmarshalJSON decodes a JSON object into ordered fields.
+func (f *Fields) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		return fmt.Errorf("expected object, got %v", tok)
+	}
+	*f = (*f)[:0]
+	for dec.More() {
+		keyTok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return err
+		}
+		var val string
+		if err := json.Unmarshal(raw, &val); err != nil {
+			// Non-string values are kept in their JSON form.
+			val = string(raw)
+		}
+		*f = append(*f, Field{Key: keyTok.(string), Value: val})
+	}
+	return nil
+}
+
+// MarshalJSON encodes the fields back into an object in their original order.
+func (f Fields) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, kv := range f {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(kv.Key)
+		v, _ := json.Marshal(kv.Value)
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// Get returns the value for key, or "" if absent.
+func (f Fields) Get(key string) string {
+	for _, kv := range f {
+		if kv.Key == key {
+			return kv.Value
+		}
+	}
+	return ""
+}
+
+// RegistrationGroup is one titled section of a 증권신고서 주요정보 response,
+// e.g. 일반사항, 증권의종류, 인수인정보, 자금의사용목적.
+type RegistrationGroup struct {
+	Title string   `json:"title"`
+	Items []Fields `json:"list"`
+}
+
+// registrationResponse wraps the 증권신고서 주요정보 endpoints.
+type registrationResponse struct {
+	BaseResponse
+	Groups []RegistrationGroup `json:"group"`
+}
+
+// RegistrationKinds maps 증권신고서 kinds to their endpoints, in display order.
+var RegistrationKinds = []struct {
+	Name string
+	Path string
+}{
+	{"지분증권", "/api/estkRs.json"},
+	{"채무증권", "/api/bdRs.json"},
+	{"증권예탁증권", "/api/stkdpRs.json"},
+	{"합병", "/api/mgRs.json"},
+	{"분할", "/api/dvRs.json"},
+	{"주식의포괄적교환·이전", "/api/extrRs.json"},
+}
+
+// GetRegistration fetches one 증권신고서 주요정보 endpoint (see RegistrationKinds).
+// Status 013 (조회된 데이터 없음) yields no groups.
+func (c *Client) GetRegistration(path string, opts EventOptions) ([]RegistrationGroup, error) {
+	params := url.Values{}
+	params.Set("corp_code", opts.CorpCode)
+	params.Set("bgn_de", opts.StartDate)
+	params.Set("end_de", opts.EndDate)
+
+	var result registrationResponse
+	if err := c.get(path, params, &result); err != nil {
+		return nil, err
+	}
+	if result.Status == "013" {
+		return nil, nil
+	}
+	if err := checkStatus(result.BaseResponse); err != nil {
+		return nil, err
+	}
+	return result.Groups, nil
+}
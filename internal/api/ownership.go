@@ -0,0 +1,17 @@
+package api
+
+import "net/url"
+
+// GetMajorStock fetches 대량보유 상황보고 (5% rule) filings for a corp.
+func (c *Client) GetMajorStock(corpCode string) ([]MajorStockReport, error) {
+	params := url.Values{}
+	params.Set("corp_code", corpCode)
+	return getList[MajorStockReport](c, "/api/majorstock.json", params)
+}
+
+// GetExecutiveStock fetches 임원ㆍ주요주주 소유보고 filings for a corp.
+func (c *Client) GetExecutiveStock(corpCode string) ([]ExecutiveStockReport, error) {
+	params := url.Values{}
+	params.Set("corp_code", corpCode)
+	return getList[ExecutiveStockReport](c, "/api/elestock.json", params)
+}
@@ -0,0 +1,11 @@
+package api
+
+// GetPublicFundUsage fetches 공모자금의 사용내역.
+func (c *Client) GetPublicFundUsage(opts ReportOptions) ([]PublicFundUsage, error) {
+	return getReport[PublicFundUsage](c, "/api/pssrpCptalUseDtls.json", opts)
+}
+
+// GetPrivateFundUsage fetches 사모자금의 사용내역.
+func (c *Client) GetPrivateFundUsage(opts ReportOptions) ([]PrivateFundUsage, error) {
+	return getReport[PrivateFundUsage](c, "/api/prvsrpCptalUseDtls.json", opts)
+}
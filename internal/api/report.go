@@ -0,0 +1,25 @@
+package api
+
+import "net/url"
+
+// ReportOptions configures 정기보고서 주요정보 queries, which all share the
+// corp_code / bsns_year / reprt_code parameter set.
+type ReportOptions struct {
+	CorpCode  string
+	BsnsYear  string // 4-digit year
+	ReprtCode string // 11011=annual, 11013=q1, 11012=half, 11014=q3
+}
+
+// getReport fetches one 정기보고서 주요정보 endpoint.
+func getReport[T any](c *Client, path string, opts ReportOptions) ([]T, error) {
+	params := url.Values{}
+	params.Set("corp_code", opts.CorpCode)
+	params.Set("bsns_year", opts.BsnsYear)
+	params.Set("reprt_code", opts.ReprtCode)
+	return getList[T](c, path, params)
+}
+
+// GetStockTotal fetches 주식의 총수 현황.
+func (c *Client) GetStockTotal(opts ReportOptions) ([]StockTotal, error) {
+	return getReport[StockTotal](c, "/api/stockTotqySttus.json", opts)
+}
@@ -44,7 +44,9 @@ type DisclosureItem struct {
 // ListResponse wraps GET /api/list.json.
 type ListResponse struct {
 	BaseResponse
+	PageNo     int              `json:"page_no"`
 	TotalCount int              `json:"total_count"`
+	TotalPage  int              `json:"total_page"`
 	Items      []DisclosureItem `json:"list"`
 }
 
@@ -54,6 +56,7 @@ type FinanceAccount struct {
 	ReprtCode   string `json:"reprt_code"`
 	BsnsYear    string `json:"bsns_year"`
 	CorpCode    string `json:"corp_code"`
+	FsDiv       string `json:"fs_div"`
 	SjDiv       string `json:"sj_div"`
 	SjNm        string `json:"sj_nm"`
 	AccountId   string `json:"account_id"`
@@ -74,3 +77,526 @@ type FinanceResponse struct {
 	BaseResponse
 	Items []FinanceAccount `json:"list"`
 }
+
+// ReportHeader holds the fields common to every 정기보고서 주요정보 row.
+type ReportHeader struct {
+	RceptNo  string `json:"rcept_no"`
+	CorpCls  string `json:"corp_cls"`
+	CorpCode string `json:"corp_code"`
+	CorpName string `json:"corp_name"`
+}
+
+// Receipt returns the 접수번호 the row was filed under.
+func (h ReportHeader) Receipt() string { return h.RceptNo }
+
+// BondBalance is one row of GET /api/cprndNrdmpBlce.json (회사채 미상환 잔액).
+// Kind is 공모, 사모 or 합계.
+type BondBalance struct {
+	ReportHeader
+	Category string `json:"remndr_exprtn1"`
+	Kind     string `json:"remndr_exprtn2"`
+	Y1Below  string `json:"yy1_below"`
+	Y1To2    string `json:"yy1_excess_yy2_below"`
+	Y2To3    string `json:"yy2_excess_yy3_below"`
+	Y3To4    string `json:"yy3_excess_yy4_below"`
+	Y4To5    string `json:"yy4_excess_yy5_below"`
+	Y5To10   string `json:"yy5_excess_yy10_below"`
+	Y10Over  string `json:"yy10_excess"`
+	Total    string `json:"sm"`
+}
+
+// CommercialPaperBalance is one row of GET /api/entrprsBilScritsNrdmpBlce.json
+// (기업어음증권 미상환 잔액).
+type CommercialPaperBalance struct {
+	ReportHeader
+	Category string `json:"remndr_exprtn1"`
+	Kind     string `json:"remndr_exprtn2"`
+	D10Below string `json:"de10_below"`
+	D10To30  string `json:"de10_excess_de30_below"`
+	D30To90  string `json:"de30_excess_de90_below"`
+	D90To180 string `json:"de90_excess_de180_below"`
+	D180ToY1 string `json:"de180_excess_yy1_below"`
+	Y1To2    string `json:"yy1_excess_yy2_below"`
+	Y2To3    string `json:"yy2_excess_yy3_below"`
+	Y3Over   string `json:"yy3_excess"`
+	Total    string `json:"sm"`
+}
+
+// ShortTermBondBalance is one row of GET /api/srtpdPsndbtNrdmpBlce.json
+// (단기사채 미상환 잔액).
+type ShortTermBondBalance struct {
+	ReportHeader
+	Category       string `json:"remndr_exprtn1"`
+	Kind           string `json:"remndr_exprtn2"`
+	D10Below       string `json:"de10_below"`
+	D10To30        string `json:"de10_excess_de30_below"`
+	D30To90        string `json:"de30_excess_de90_below"`
+	D90To180       string `json:"de90_excess_de180_below"`
+	D180ToY1       string `json:"de180_excess_yy1_below"`
+	Total          string `json:"sm"`
+	IssueLimit     string `json:"isu_lmt"`
+	RemainingLimit string `json:"remndr_lmt"`
+}
+
+// HybridCapitalBalance is one row of GET /api/newCaplScritsNrdmpBlce.json
+// (신종자본증권 미상환 잔액).
+type HybridCapitalBalance struct {
+	ReportHeader
+	Category string `json:"remndr_exprtn1"`
+	Kind     string `json:"remndr_exprtn2"`
+	Y1Below  string `json:"yy1_below"`
+	Y1To5    string `json:"yy1_excess_yy5_below"`
+	Y5To10   string `json:"yy5_excess_yy10_below"`
+	Y10To15  string `json:"yy10_excess_yy15_below"`
+	Y15To20  string `json:"yy15_excess_yy20_below"`
+	Y20To30  string `json:"yy20_excess_yy30_below"`
+	Y30Over  string `json:"yy30_excess"`
+	Total    string `json:"sm"`
+}
+
+// ContingentCapitalBalance is one row of GET /api/cndlCaplScritsNrdmpBlce.json
+// (조건부자본증권 미상환 잔액).
+type ContingentCapitalBalance struct {
+	ReportHeader
+	Category string `json:"remndr_exprtn1"`
+	Kind     string `json:"remndr_exprtn2"`
+	Y1Below  string `json:"yy1_below"`
+	Y1To2    string `json:"yy1_excess_yy2_below"`
+	Y2To3    string `json:"yy2_excess_yy3_below"`
+	Y3To4    string `json:"yy3_excess_yy4_below"`
+	Y4To5    string `json:"yy4_excess_yy5_below"`
+	Y5To10   string `json:"yy5_excess_yy10_below"`
+	Y10To20  string `json:"yy10_excess_yy20_below"`
+	Y20To30  string `json:"yy20_excess_yy30_below"`
+	Y30Over  string `json:"yy30_excess"`
+	Total    string `json:"sm"`
+}
+
+// DebtIssuance is one row of GET /api/detScritsIsuAcmslt.json (채무증권 발행실적).
+type DebtIssuance struct {
+	ReportHeader
+	Issuer       string `json:"isu_cmpny"`
+	SecurityType string `json:"scrits_knd_nm"`
+	IssueMethod  string `json:"isu_mth_nm"`
+	IssueDate    string `json:"isu_de"`
+	FaceValue    string `json:"facvalu_totamt"`
+	InterestRate string `json:"intrt"`
+	Rating       string `json:"evl_grad_instt"`
+	Maturity     string `json:"mtd"`
+	Redeemed     string `json:"repy_at"`
+	Underwriter  string `json:"mngt_cmpny"`
+}
+
+// AuditOpinion is one row of GET /api/accnutAdtorNmNdAdtOpinion.json
+// (회계감사인의 명칭 및 감사의견). BsnsYear is relative, e.g. "제55기(당기)".
+type AuditOpinion struct {
+	ReportHeader
+	BsnsYear        string `json:"bsns_year"`
+	Auditor         string `json:"adtor"`
+	Opinion         string `json:"adt_opinion"`
+	SpecialMatter   string `json:"adt_reprt_spcmnt_matter"`
+	EmphasisMatter  string `json:"emphs_matter"`
+	KeyAuditMatters string `json:"core_adt_matter"`
+}
+
+// AuditServiceContract is one row of GET /api/adtServcCnclsSttus.json (감사용역체결현황).
+type AuditServiceContract struct {
+	ReportHeader
+	BsnsYear      string `json:"bsns_year"`
+	Auditor       string `json:"adtor"`
+	Content       string `json:"cn"`
+	Fee           string `json:"mendng"`
+	TotalHours    string `json:"tot_reqre_time"`
+	ContractFee   string `json:"adt_cntrct_dtls_mendng"`
+	ContractHours string `json:"adt_cntrct_dtls_time"`
+	ActualFee     string `json:"real_exc_dtls_mendng"`
+	ActualHours   string `json:"real_exc_dtls_time"`
+}
+
+// NonAuditServiceContract is one row of
+// GET /api/accnutAdtorNonAdtServcCnclsSttus.json (비감사용역 계약체결 현황).
+type NonAuditServiceContract struct {
+	ReportHeader
+	BsnsYear     string `json:"bsns_year"`
+	ContractDate string `json:"cntrct_cncls_de"`
+	Service      string `json:"servc_cn"`
+	Period       string `json:"servc_exc_pd"`
+	Fee          string `json:"servc_mendng"`
+	Remark       string `json:"rm"`
+}
+
+// MajorStockReport is one row of GET /api/majorstock.json (대량보유 상황보고).
+type MajorStockReport struct {
+	RceptNo        string `json:"rcept_no"`
+	RceptDt        string `json:"rcept_dt"`
+	CorpCode       string `json:"corp_code"`
+	CorpName       string `json:"corp_name"`
+	ReportType     string `json:"report_tp"`
+	Reporter       string `json:"repror"`
+	Shares         string `json:"stkqy"`
+	SharesChange   string `json:"stkqy_irds"`
+	StakeRate      string `json:"stkrt"`
+	StakeChange    string `json:"stkrt_irds"`
+	ContractShares string `json:"ctr_stkqy"`
+	ContractRate   string `json:"ctr_stkrt"`
+	Reason         string `json:"report_resn"`
+}
+
+// ExecutiveStockReport is one row of GET /api/elestock.json (임원ㆍ주요주주 소유보고).
+type ExecutiveStockReport struct {
+	RceptNo          string `json:"rcept_no"`
+	RceptDt          string `json:"rcept_dt"`
+	CorpCode         string `json:"corp_code"`
+	CorpName         string `json:"corp_name"`
+	Reporter         string `json:"repror"`
+	RegisteredExec   string `json:"isu_exctv_rgist_at"`
+	Position         string `json:"isu_exctv_ofcps"`
+	MajorShareholder string `json:"isu_main_shrholdr"`
+	Shares           string `json:"sp_stock_lmp_cnt"`
+	SharesChange     string `json:"sp_stock_lmp_irds_cnt"`
+	StakeRate        string `json:"sp_stock_lmp_rate"`
+	StakeChange      string `json:"sp_stock_lmp_irds_rate"`
+}
+
+// StockTotal is one row of GET /api/stockTotqySttus.json (주식의 총수 현황).
+// Kind (se) is 보통주, 우선주 or 합계.
+type StockTotal struct {
+	ReportHeader
+	Kind          string `json:"se"`
+	AuthorizedQty string `json:"isu_stock_totqy"`
+	IssuedQty     string `json:"istc_totqy"`
+	TreasuryQty   string `json:"tesstk_co"`
+	FloatingQty   string `json:"distb_stock_co"`
+}
+
+// FundPurposes is the 자금조달의 목적 breakdown shared by offering decisions.
+type FundPurposes struct {
+	Facility    string `json:"fdpp_fclt"`
+	Acquisition string `json:"fdpp_bsninh"`
+	Operation   string `json:"fdpp_op"`
+	DebtRepay   string `json:"fdpp_dtrp"`
+	Securities  string `json:"fdpp_ocsa"`
+	Etc         string `json:"fdpp_etc"`
+}
+
+// BondDecisionTerms holds the fields shared by CB/BW/EB issuance decisions.
+type BondDecisionTerms struct {
+	ReportHeader
+	FundPurposes
+	Series         string `json:"bd_tm"`
+	BondKind       string `json:"bd_knd"`
+	FaceValue      string `json:"bd_fta"`
+	CouponRate     string `json:"bd_intr_ex"`
+	YieldToMat     string `json:"bd_intr_sf"`
+	Maturity       string `json:"bd_mtd"`
+	IssueMethod    string `json:"bdis_mthn"`
+	RefixFloor     string `json:"act_mktprcfl_cvprc_lwtrsprc"`
+	RefixBasis     string `json:"act_mktprcfl_cvprc_lwtrsprc_bs"`
+	SubscriptionDt string `json:"sbd"`
+	PaymentDt      string `json:"pymd"`
+	LeadManager    string `json:"rpmcmp"`
+	BoardDate      string `json:"bddd"`
+}
+
+// ConvertibleBondDecision is one row of GET /api/cvbdIsDecsn.json (전환사채권 발행결정).
+type ConvertibleBondDecision struct {
+	BondDecisionTerms
+	ConvRate    string `json:"cv_rt"`
+	ConvPrice   string `json:"cv_prc"`
+	ShareKind   string `json:"cvisstk_knd"`
+	ShareCount  string `json:"cvisstk_cnt"`
+	ShareRatio  string `json:"cvisstk_tisstk_vs"`
+	PeriodStart string `json:"cvrqpd_bgd"`
+	PeriodEnd   string `json:"cvrqpd_edd"`
+}
+
+// WarrantBondDecision is one row of GET /api/bdwtIsDecsn.json (신주인수권부사채권 발행결정).
+type WarrantBondDecision struct {
+	BondDecisionTerms
+	ExerciseRate  string `json:"ex_rt"`
+	ExercisePrice string `json:"ex_prc"`
+	Detachable    string `json:"bdwt_div_atn"`
+	ShareKind     string `json:"nstk_isstk_knd"`
+	ShareCount    string `json:"nstk_isstk_cnt"`
+	ShareRatio    string `json:"nstk_isstk_tisstk_vs"`
+	PeriodStart   string `json:"expd_bgd"`
+	PeriodEnd     string `json:"expd_edd"`
+}
+
+// ExchangeableBondDecision is one row of GET /api/exbdIsDecsn.json (교환사채권 발행결정).
+type ExchangeableBondDecision struct {
+	BondDecisionTerms
+	ExchangeRate  string `json:"ex_rt"`
+	ExchangePrice string `json:"ex_prc"`
+	TargetKind    string `json:"extg"`
+	ShareCount    string `json:"extg_stkcnt"`
+	ShareRatio    string `json:"extg_tisstk_vs"`
+	PeriodStart   string `json:"exrqpd_bgd"`
+	PeriodEnd     string `json:"exrqpd_edd"`
+}
+
+// MergerDecision is one row of GET /api/cmpMgDecsn.json (회사합병 결정).
+type MergerDecision struct {
+	ReportHeader
+	Method          string `json:"mg_mth"`
+	Form            string `json:"mg_stn"`
+	Purpose         string `json:"mg_pp"`
+	Ratio           string `json:"mg_rt"`
+	NewCommonShares string `json:"mgnstk_ostk_cnt"`
+	NewPrefShares   string `json:"mgnstk_cmpstk_cnt"`
+	Counterparty    string `json:"mgptncmp_cmpnm"`
+	CounterpartyBiz string `json:"mgptncmp_mbsn"`
+	Relation        string `json:"mgptncmp_rl_cmpn"`
+	ContractDate    string `json:"mgsc_mgctrd"`
+	EffectiveDate   string `json:"mgsc_mgdt"`
+	BoardDate       string `json:"bddd"`
+}
+
+// SplitDecision is one row of GET /api/cmpDvDecsn.json (회사분할 결정).
+type SplitDecision struct {
+	ReportHeader
+	Method        string `json:"dv_mth"`
+	Ratio         string `json:"dv_rt"`
+	Business      string `json:"dv_trfbsnprt_cn"`
+	NewCompany    string `json:"dvfcmp_cmpnm"`
+	EffectiveDate string `json:"dvdt"`
+	BoardDate     string `json:"bddd"`
+}
+
+// SplitMergerDecision is one row of GET /api/cmpDvmgDecsn.json (회사분할합병 결정).
+type SplitMergerDecision struct {
+	ReportHeader
+	Method        string `json:"dvmg_mth"`
+	Ratio         string `json:"dvmg_rt"`
+	Counterparty  string `json:"mgptncmp_cmpnm"`
+	Relation      string `json:"mgptncmp_rl_cmpn"`
+	EffectiveDate string `json:"dvmgsc_dvmgdt"`
+	BoardDate     string `json:"bddd"`
+}
+
+// ShareExchangeDecision is one row of GET /api/stkExtrDecsn.json (주식교환·이전 결정).
+type ShareExchangeDecision struct {
+	ReportHeader
+	Kind          string `json:"extr_sen"`
+	Form          string `json:"extr_stn"`
+	Counterparty  string `json:"extr_tgcmp_cmpnm"`
+	Relation      string `json:"extr_tgcmp_rl_cmpn"`
+	Ratio         string `json:"extr_rt"`
+	Purpose       string `json:"extr_pp"`
+	EffectiveDate string `json:"extrsc_extrdt"`
+	BoardDate     string `json:"bddd"`
+}
+
+// BusinessTransferDecision is one row of GET /api/bsnInhDecsn.json (영업양수)
+// or /api/bsnTrfDecsn.json (영업양도). The two endpoints use inh_/trf_
+// prefixes for the same fields, so both spellings are decoded.
+type BusinessTransferDecision struct {
+	ReportHeader
+	InhBusiness  string `json:"inh_bsn"`
+	TrfBusiness  string `json:"trf_bsn"`
+	InhPrice     string `json:"inh_prc"`
+	TrfPrice     string `json:"trf_prc"`
+	InhPurpose   string `json:"inh_pp"`
+	TrfPurpose   string `json:"trf_pp"`
+	InhDate      string `json:"inh_prd_inh_std"`
+	TrfDate      string `json:"trf_prd_trf_std"`
+	Counterparty string `json:"dlptn_cmpnm"`
+	Relation     string `json:"dlptn_rl_cmpn"`
+	BoardDate    string `json:"bddd"`
+}
+
+// AssetTransferDecision is one row of GET /api/tgastInhDecsn.json (유형자산 양수)
+// or /api/tgastTrfDecsn.json (유형자산 양도).
+type AssetTransferDecision struct {
+	ReportHeader
+	AssetKind    string `json:"ast_sen"`
+	AssetName    string `json:"ast_nm"`
+	InhPrice     string `json:"inhdtl_inhprc"`
+	TrfPrice     string `json:"trfdtl_trfprc"`
+	InhPurpose   string `json:"inh_pp"`
+	TrfPurpose   string `json:"trf_pp"`
+	InhDate      string `json:"inhscd_rgsprd"`
+	TrfDate      string `json:"trfscd_rgsprd"`
+	Counterparty string `json:"dlptn_cmpnm"`
+	Relation     string `json:"dlptn_rl_cmpn"`
+	BoardDate    string `json:"bddd"`
+}
+
+// StockTransferDecision is one row of GET /api/otcprStkInvscrInhDecsn.json
+// (타법인 주식 및 출자증권 양수) or /api/otcprStkInvscrTrfDecsn.json (양도).
+type StockTransferDecision struct {
+	ReportHeader
+	Issuer         string `json:"iscmp_cmpnm"`
+	IssuerRelation string `json:"iscmp_rl_cmpn"`
+	InhShares      string `json:"inhdtl_stkcnt"`
+	TrfShares      string `json:"trfdtl_stkcnt"`
+	InhPrice       string `json:"inhdtl_inhprc"`
+	TrfPrice       string `json:"trfdtl_trfprc"`
+	InhPurpose     string `json:"inh_pp"`
+	TrfPurpose     string `json:"trf_pp"`
+	InhDate        string `json:"inh_prd"`
+	TrfDate        string `json:"trf_prd"`
+	Counterparty   string `json:"dlptn_cmpnm"`
+	Relation       string `json:"dlptn_rl_cmpn"`
+	BoardDate      string `json:"bddd"`
+}
+
+// DefaultOccurrence is one row of GET /api/dfOcr.json (부도발생).
+type DefaultOccurrence struct {
+	ReportHeader
+	Content string `json:"df_cn"`
+	Amount  string `json:"df_amt"`
+	Bank    string `json:"df_bnk"`
+	Date    string `json:"dfd"`
+	Reason  string `json:"df_rs"`
+}
+
+// BusinessSuspension is one row of GET /api/bsnSp.json (영업정지).
+type BusinessSuspension struct {
+	ReportHeader
+	Area        string `json:"bsnsp_rm"`
+	Amount      string `json:"bsnsp_amt"`
+	RecentSales string `json:"rsl"`
+	SalesRatio  string `json:"sl_vs"`
+	Content     string `json:"bsnsp_cn"`
+	Reason      string `json:"bsnsp_rs"`
+	Date        string `json:"bsnspd"`
+	BoardDate   string `json:"bddd"`
+}
+
+// RehabilitationFiling is one row of GET /api/ctrcvsBgrq.json (회생절차 개시신청).
+type RehabilitationFiling struct {
+	ReportHeader
+	Applicant string `json:"apcnt"`
+	Court     string `json:"cpct"`
+	Reason    string `json:"rq_rs"`
+	Date      string `json:"rqd"`
+	Plan      string `json:"ft_ctp_sc"`
+}
+
+// DissolutionCause is one row of GET /api/dsRsOcr.json (해산사유 발생).
+type DissolutionCause struct {
+	ReportHeader
+	Reason string `json:"ds_rs"`
+	Date   string `json:"ds_rsd"`
+}
+
+// CreditorManagement is one row of GET /api/bnkMngtPcbg.json (채권은행 등의 관리절차 개시).
+type CreditorManagement struct {
+	ReportHeader
+	Date        string `json:"mngt_pcbg_dd"`
+	Institution string `json:"mngt_int"`
+	Period      string `json:"mngt_pd"`
+	Reason      string `json:"mngt_rs"`
+}
+
+// PaidInCapitalDecision is one row of GET /api/piicDecsn.json (유상증자 결정).
+type PaidInCapitalDecision struct {
+	ReportHeader
+	FundPurposes
+	NewCommon    string `json:"nstk_ostk_cnt"`
+	NewOther     string `json:"nstk_estk_cnt"`
+	ParValue     string `json:"fv_ps"`
+	PreCommon    string `json:"bfic_tisstk_ostk"`
+	PreOther     string `json:"bfic_tisstk_estk"`
+	Method       string `json:"ic_mthn"`
+	ShortSelling string `json:"ssl_at"`
+}
+
+// BonusIssueDecision is one row of GET /api/fricDecsn.json (무상증자 결정).
+type BonusIssueDecision struct {
+	ReportHeader
+	NewCommon      string `json:"nstk_ostk_cnt"`
+	NewOther       string `json:"nstk_estk_cnt"`
+	ParValue       string `json:"fv_ps"`
+	PreCommon      string `json:"bfic_tisstk_ostk"`
+	PreOther       string `json:"bfic_tisstk_estk"`
+	RecordDate     string `json:"nstk_asstd"`
+	PerShareCommon string `json:"nstk_ascnt_ps_ostk"`
+	DeliveryDate   string `json:"nstk_dlprd"`
+	ListingDate    string `json:"nstk_lstprd"`
+	BoardDate      string `json:"bddd"`
+}
+
+// MixedCapitalDecision is one row of GET /api/pifricDecsn.json (유무상증자 결정).
+// Paid-in fields carry the piic_ prefix and bonus fields the fric_ prefix.
+type MixedCapitalDecision struct {
+	ReportHeader
+	PaidNewCommon   string `json:"piic_nstk_ostk_cnt"`
+	PaidPreCommon   string `json:"piic_bfic_tisstk_ostk"`
+	PaidMethod      string `json:"piic_ic_mthn"`
+	PaidFacility    string `json:"piic_fdpp_fclt"`
+	PaidAcquisition string `json:"piic_fdpp_bsninh"`
+	PaidOperation   string `json:"piic_fdpp_op"`
+	PaidDebtRepay   string `json:"piic_fdpp_dtrp"`
+	PaidSecurities  string `json:"piic_fdpp_ocsa"`
+	PaidEtc         string `json:"piic_fdpp_etc"`
+	BonusNewCommon  string `json:"fric_nstk_ostk_cnt"`
+	BonusPreCommon  string `json:"fric_bfic_tisstk_ostk"`
+	BonusRecordDate string `json:"fric_nstk_asstd"`
+	BonusPerShare   string `json:"fric_nstk_ascnt_ps_ostk"`
+	BoardDate       string `json:"bddd"`
+}
+
+// CapitalReductionDecision is one row of GET /api/crDecsn.json (감자 결정).
+type CapitalReductionDecision struct {
+	ReportHeader
+	ReducedCommon string `json:"crstk_ostk_cnt"`
+	ReducedOther  string `json:"crstk_estk_cnt"`
+	ParValue      string `json:"fv_ps"`
+	PreCapital    string `json:"bfcr_cpt"`
+	PostCapital   string `json:"atcr_cpt"`
+	PreCommon     string `json:"bfcr_tisstk_ostk"`
+	PostCommon    string `json:"atcr_tisstk_ostk"`
+	RatioCommon   string `json:"cr_rt_ostk"`
+	RecordDate    string `json:"cr_std"`
+	Method        string `json:"cr_mth"`
+	Reason        string `json:"cr_rs"`
+	ListingDate   string `json:"crsc_nstklstprd"`
+	BoardDate     string `json:"bddd"`
+}
+
+// LawsuitFiling is one row of GET /api/lwstLg.json (소송 등의 제기).
+// The claim is free text; amounts must be parsed out of Claim.
+type LawsuitFiling struct {
+	ReportHeader
+	CaseName  string `json:"icnm"`
+	Plaintiff string `json:"ac_ap"`
+	Claim     string `json:"rq_cn"`
+	Court     string `json:"cpct"`
+	Plan      string `json:"ft_ctp"`
+	FiledDate string `json:"lgd"`
+	Confirmed string `json:"cfd"`
+}
+
+// FundUsage holds the fields shared by the 공모/사모자금 사용내역 rows. A
+// financing round spans several rows, one per planned or actual purpose.
+type FundUsage struct {
+	ReportHeader
+	Kind          string `json:"se_nm"`
+	Round         string `json:"tm"`
+	PaidDate      string `json:"pay_de"`
+	PaidAmount    string `json:"pay_amount"`
+	ActualStatus  string `json:"real_cptal_use_sttus"`
+	ActualPurpose string `json:"real_cptal_use_dtls_cn"`
+	ActualAmount  string `json:"real_cptal_use_dtls_amount"`
+	DiffReason    string `json:"dffrnc_occrrnc_resn"`
+}
+
+// PublicFundUsage is one row of GET /api/pssrpCptalUseDtls.json (공모자금의 사용내역).
+// The plan comes from the 증권신고서.
+type PublicFundUsage struct {
+	FundUsage
+	Plan        string `json:"on_dclrt_cptal_use_plan"`
+	PlanPurpose string `json:"rs_cptal_use_plan_useprps"`
+	PlanAmount  string `json:"rs_cptal_use_plan_prcure_amount"`
+}
+
+// PrivateFundUsage is one row of GET /api/prvsrpCptalUseDtls.json (사모자금의 사용내역).
+// The plan comes from the 주요사항보고서.
+type PrivateFundUsage struct {
+	FundUsage
+	Plan        string `json:"cptal_use_plan"`
+	PlanPurpose string `json:"mtrpt_cptal_use_plan_useprps"`
+	PlanAmount  string `json:"mtrpt_cptal_use_plan_prcure_amount"`
+}
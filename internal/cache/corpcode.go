@@ -229,6 +229,23 @@ func Status() (exists bool, modTime time.Time, stale bool, err error) {
 	return true, mt, time.Since(mt) > cacheMaxAge, nil
 }
 
+// GetStatus returns Status as a StatusInfo, including the cache path.
+func GetStatus() (StatusInfo, error) {
+	path, err := CorpCodePath()
+	if err != nil {
+		return StatusInfo{}, err
+	}
+	exists, modTime, stale, err := Status()
+	if err != nil {
+		return StatusInfo{}, err
+	}
+	info := StatusInfo{Path: path, Exists: exists, Stale: stale}
+	if exists {
+		info.UpdatedAt = &modTime
+	}
+	return info, nil
+}
+
 // Clear removes the cache file.
 func Clear() error {
 	path, err := CorpCodePath()
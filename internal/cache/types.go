@@ -1,8 +1,18 @@
 package cache
 
+import "time"
+
 type CorpInfo struct {
 	CorpCode string `json:"corp_code"`
 	CorpName string `json:"corp_name"`
 	StockCode string `json:"stock_code"`
 	ModifyDate string `json:"modify_date"`
 }
+
+// StatusInfo describes the corp code cache file for `cache status`.
+type StatusInfo struct {
+	Path      string     `json:"path"`
+	Exists    bool       `json:"exists"`
+	UpdatedAt *time.Time `json:"updated_at"`
+	Stale     bool       `json:"stale"`
+}
@@ -9,9 +9,13 @@ import (
 
 var DefaultConfigPath string
 
+// TemplateDir holds named --template files (~/.dartcli/templates).
+var TemplateDir string
+
 func init() {
 	home, _ := os.UserHomeDir()
 	DefaultConfigPath = filepath.Join(home, ".dartcli", "config.yaml")
+	TemplateDir = filepath.Join(home, ".dartcli", "templates")
 }
 
 func Load(cfgFile string) (*Config, error) {
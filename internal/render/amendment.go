@@ -0,0 +1,189 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// reportTag matches a leading report name tag such as "[기재정정]" or
+// "[첨부추가]".
+var reportTag = regexp.MustCompile(`^\s*\[([^\]]*)\]\s*`)
+
+// AmendmentBase splits a report name into the name shared by the original
+// and its corrections ("사업보고서 (2023.12)") and its leading tags
+// ("기재정정"), so "[기재정정]사업보고서 (2023.12)" and the original match.
+func AmendmentBase(reportNm string) (base, tag string) {
+	var tags []string
+	for {
+		m := reportTag.FindStringSubmatchIndex(reportNm)
+		if m == nil {
+			break
+		}
+		tags = append(tags, strings.TrimSpace(reportNm[m[2]:m[3]]))
+		reportNm = reportNm[m[1]:]
+	}
+	return collapse(reportNm), strings.Join(tags, ", ")
+}
+
+// AmendmentChain returns the filings of items that share rceptNo's report
+// name, oldest first. It returns nil when rceptNo is not among items.
+func AmendmentChain(items []api.DisclosureItem, rceptNo string) []api.DisclosureItem {
+	var target *api.DisclosureItem
+	for i := range items {
+		if items[i].RceptNo == rceptNo {
+			target = &items[i]
+			break
+		}
+	}
+	if target == nil {
+		return nil
+	}
+	base, _ := AmendmentBase(target.ReportNm)
+	seen := map[string]bool{}
+	var chain []api.DisclosureItem
+	for _, it := range items {
+		if b, _ := AmendmentBase(it.ReportNm); b == base && it.CorpCode == target.CorpCode && !seen[it.RceptNo] {
+			seen[it.RceptNo] = true
+			chain = append(chain, it)
+		}
+	}
+	// 접수번호 starts with the filing date and then counts up.
+	sort.Slice(chain, func(i, j int) bool { return chain[i].RceptNo < chain[j].RceptNo })
+	return chain
+}
+
+// Correction is one row of the 정정사항 table DART amendments start with.
+type Correction struct {
+	Item   string `json:"item"`
+	Reason string `json:"reason,omitempty"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// Corrections reads the 정정 전/정정 후 tables of an amendment: every
+// table whose header has both columns, anywhere in docs.
+func Corrections(docs []*Document) []Correction {
+	var out []Correction
+	for _, t := range ExtractTables(docs) {
+		l := t.Table.Layout()
+		item, reason, before, after := -1, -1, -1, -1
+		for c, label := range l.Header {
+			switch compact := strings.ReplaceAll(label, " ", ""); {
+			case strings.Contains(compact, "정정전"):
+				before = c
+			case strings.Contains(compact, "정정후"):
+				after = c
+			case strings.Contains(compact, "사유"):
+				reason = c
+			case item < 0 && (strings.Contains(compact, "항목") || strings.Contains(compact, "정정사항") || strings.Contains(compact, "대상")):
+				item = c
+			}
+		}
+		if before < 0 || after < 0 {
+			continue
+		}
+		if item < 0 && before > 0 {
+			item = 0
+		}
+		cell := func(row []string, c int) string {
+			if c < 0 || c >= len(row) {
+				return ""
+			}
+			return row[c]
+		}
+		for _, row := range l.Rows {
+			cr := Correction{Item: cell(row, item), Reason: cell(row, reason), Before: cell(row, before), After: cell(row, after)}
+			if cr.Before == "" && cr.After == "" {
+				continue
+			}
+			out = append(out, cr)
+		}
+	}
+	return out
+}
+
+// Amendment is one filing of a report's correction history. Diff compares
+// it with the filing before it.
+type Amendment struct {
+	RceptNo     string        `json:"rcept_no"`
+	RceptDt     string        `json:"rcept_dt"`
+	ReportNm    string        `json:"report_nm"`
+	Tag         string        `json:"tag,omitempty"`
+	Corrections []Correction  `json:"corrections,omitempty"`
+	Diff        *DocumentDiff `json:"diff,omitempty"`
+}
+
+// AmendmentHistory is amendments output: the original filing and its
+// corrections, oldest first.
+type AmendmentHistory struct {
+	CorpName string      `json:"corp_name"`
+	ReportNm string      `json:"report_nm"`
+	Filings  []Amendment `json:"filings"`
+}
+
+// Tables implements Tabular with the filing history and the rows of every
+// correction table.
+func (h *AmendmentHistory) Tables() []Table {
+	filings := Table{Name: "정정 이력", Header: []string{"순서", "접수일", "공시명", "접수번호", "구분", "변경 섹션"}}
+	corrections := Table{Name: "정정 내역", Header: []string{"접수번호", "항목", "사유", "정정 전", "정정 후"}}
+	for i, f := range h.Filings {
+		var changed any
+		if f.Diff != nil {
+			changed = int64(len(f.Diff.Changed()))
+		}
+		filings.Rows = append(filings.Rows, []any{int64(i + 1), FormatDate(f.RceptDt), f.ReportNm, f.RceptNo, amendmentKind(f), changed})
+		for _, c := range f.Corrections {
+			corrections.Rows = append(corrections.Rows, []any{f.RceptNo, c.Item, c.Reason, c.Before, c.After})
+		}
+	}
+	return []Table{filings, corrections}
+}
+
+func amendmentKind(f Amendment) string {
+	if f.Tag == "" {
+		return "최초"
+	}
+	return f.Tag
+}
+
+// AmendmentsMarkdown lists the filings of a report and, for each
+// correction, its 정정사항 table and the changes against the filing
+// before it.
+func AmendmentsMarkdown(h *AmendmentHistory, sideBySide bool) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# %s 정정 이력: %s\n\n", h.CorpName, h.ReportNm)
+
+	sb.WriteString("| # | 접수일 | 공시명 | 접수번호 | 구분 |\n")
+	sb.WriteString("|---:|---|---|---|---|\n")
+	for i, f := range h.Filings {
+		fmt.Fprintf(&sb, "| %d | %s | %s | `%s` | %s |\n", i+1, FormatDate(f.RceptDt), escapePipes(f.ReportNm), f.RceptNo, amendmentKind(f))
+	}
+	sb.WriteString("\n")
+
+	for i, f := range h.Filings {
+		if f.Diff == nil {
+			continue
+		}
+		fmt.Fprintf(&sb, "## %d. %s (%s, `%s`)\n\n", i+1, f.ReportNm, FormatDate(f.RceptDt), f.RceptNo)
+
+		sb.WriteString("### 정정 내역\n\n")
+		if len(f.Corrections) == 0 {
+			sb.WriteString("정정사항 표를 찾지 못했습니다. 아래 원문 변경을 확인하세요.\n\n")
+		} else {
+			sb.WriteString("| 항목 | 사유 | 정정 전 | 정정 후 |\n|---|---|---|---|\n")
+			for _, c := range f.Corrections {
+				fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n",
+					escapePipes(c.Item), escapePipes(c.Reason), escapePipes(c.Before), escapePipes(c.After))
+			}
+			sb.WriteString("\n")
+		}
+
+		fmt.Fprintf(&sb, "### 원문 변경 (`%s` 대비)\n\n", f.Diff.Old)
+		writeDiff(&sb, f.Diff, 4, sideBySide, true)
+	}
+	return sb.String()
+}
@@ -0,0 +1,47 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestAmendmentChain(t *testing.T) {
+	if base, tag := AmendmentBase("[기재정정][첨부추가] 사업보고서 (2023.12)"); base != "사업보고서 (2023.12)" || tag != "기재정정, 첨부추가" {
+		t.Errorf("AmendmentBase = %q, %q", base, tag)
+	}
+
+	items := []api.DisclosureItem{
+		{CorpCode: "1", ReportNm: "[기재정정]사업보고서 (2023.12)", RceptNo: "20240520000100"},
+		{CorpCode: "1", ReportNm: "분기보고서 (2024.03)", RceptNo: "20240514000200"},
+		{CorpCode: "1", ReportNm: "[기재정정]사업보고서 (2023.12)", RceptNo: "20240401000300"},
+		{CorpCode: "1", ReportNm: "사업보고서 (2023.12)", RceptNo: "20240312000400", RmFlag: "정"},
+		{CorpCode: "1", ReportNm: "사업보고서 (2022.12)", RceptNo: "20230310000500"},
+	}
+	var got []string
+	for _, it := range AmendmentChain(items, "20240401000300") {
+		got = append(got, it.RceptNo)
+	}
+	if s := strings.Join(got, ","); s != "20240312000400,20240401000300,20240520000100" {
+		t.Errorf("정정 체인 = %s", s)
+	}
+	if AmendmentChain(items, "20990101000000") != nil {
+		t.Error("목록에 없는 접수번호는 nil이어야 합니다")
+	}
+}
+
+func TestCorrections(t *testing.T) {
+	const xml = `<DOCUMENT><DOCUMENT-NAME>사업보고서</DOCUMENT-NAME><BODY>
+<SECTION-1><TITLE ATOC="Y">정정신고(보고)</TITLE>
+<P>1. 정정대상 공시서류 : 사업보고서 (2023.12)</P>
+<TABLE><THEAD><TR><TH>정정대상 항목</TH><TH>정정사유</TH><TH>정 정 전</TH><TH>정 정 후</TH></TR></THEAD>
+<TBODY><TR><TD>II. 사업의 내용</TD><TD>단순 기재오류</TD><TD>매출액 1,000</TD><TD>매출액 1,200</TD></TR></TBODY></TABLE>
+</SECTION-1></BODY></DOCUMENT>`
+
+	got := Corrections([]*Document{ParseDocument([]byte(xml))})
+	want := Correction{Item: "II. 사업의 내용", Reason: "단순 기재오류", Before: "매출액 1,000", After: "매출액 1,200"}
+	if len(got) != 1 || got[0] != want {
+		t.Errorf("정정 내역 = %+v", got)
+	}
+}
@@ -0,0 +1,259 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// AuditSource holds the audit rows taken from one annual report.
+type AuditSource struct {
+	Year     int // 사업연도 of the report the rows came from
+	Opinions []api.AuditOpinion
+	Fees     []api.AuditServiceContract
+	NonAudit []api.NonAuditServiceContract
+}
+
+// AuditYear is the consolidated audit record for one fiscal year.
+type AuditYear struct {
+	Year            int    `json:"year"`
+	Auditor         string `json:"auditor"`
+	Opinion         string `json:"opinion"`
+	EmphasisMatter  string `json:"emphasis_matter"`
+	KeyAuditMatters string `json:"key_audit_matters"`
+	ContractFee     string `json:"contract_fee"`
+	ContractHours   string `json:"contract_hours"`
+	ActualFee       string `json:"actual_fee"`
+	ActualHours     string `json:"actual_hours"`
+	NonAuditFee     int64  `json:"non_audit_fee"`
+	NonAuditCount   int    `json:"non_audit_count"`
+	AuditorChanged  bool   `json:"auditor_changed"`
+	Modified        bool   `json:"modified"` // opinion other than 적정
+}
+
+var fourDigitYear = regexp.MustCompile(`(19|20)\d{2}`)
+
+// RelativeYear resolves a bsns_year label such as "제55기(당기)" or
+// "제54기(전기)" against the report year. Labels that already carry a
+// four-digit year are returned as-is.
+func RelativeYear(label string, reportYear int) int {
+	if m := fourDigitYear.FindString(label); m != "" {
+		y, _ := strconv.Atoi(m)
+		return y
+	}
+	switch {
+	case strings.Contains(label, "전전기"):
+		return reportYear - 2
+	case strings.Contains(label, "전기"):
+		return reportYear - 1
+	default:
+		return reportYear
+	}
+}
+
+// IsUnqualifiedOpinion reports whether op is an unqualified (적정) opinion.
+// Empty or "-" opinions (e.g. 검토 only) are treated as unqualified.
+func IsUnqualifiedOpinion(op string) bool {
+	op = strings.ReplaceAll(op, " ", "")
+	if op == "" || op == "-" {
+		return true
+	}
+	if strings.Contains(op, "부적정") || strings.Contains(op, "한정") || strings.Contains(op, "거절") {
+		return false
+	}
+	return strings.Contains(op, "적정")
+}
+
+// AuditHistory merges several reports into one record per fiscal year.
+// A year's own report wins over the 전기/전전기 rows restated in later
+// reports. The result is sorted oldest first with auditor changes flagged.
+func AuditHistory(sources []AuditSource) []AuditYear {
+	type slot struct {
+		rec    AuditYear
+		offset int // 0 = taken from the year's own report
+		feeOff int
+		naOff  int
+	}
+	years := map[int]*slot{}
+	get := func(y int) *slot {
+		if years[y] == nil {
+			years[y] = &slot{rec: AuditYear{Year: y}, offset: 99, feeOff: 99, naOff: 99}
+		}
+		return years[y]
+	}
+
+	for _, src := range sources {
+		for _, o := range src.Opinions {
+			y := RelativeYear(o.BsnsYear, src.Year)
+			s := get(y)
+			if off := src.Year - y; off < s.offset {
+				s.offset = off
+				s.rec.Auditor = collapse(o.Auditor)
+				s.rec.Opinion = collapse(o.Opinion)
+				s.rec.EmphasisMatter = collapse(o.EmphasisMatter)
+				s.rec.KeyAuditMatters = collapse(o.KeyAuditMatters)
+			}
+		}
+		for _, f := range src.Fees {
+			y := RelativeYear(f.BsnsYear, src.Year)
+			s := get(y)
+			if off := src.Year - y; off < s.feeOff {
+				s.feeOff = off
+				s.rec.ContractFee = firstNonEmpty(f.ContractFee, f.Fee)
+				s.rec.ContractHours = firstNonEmpty(f.ContractHours, f.TotalHours)
+				s.rec.ActualFee = f.ActualFee
+				s.rec.ActualHours = f.ActualHours
+				if s.rec.Auditor == "" {
+					s.rec.Auditor = collapse(f.Auditor)
+				}
+			}
+		}
+		// Non-audit rows are additive, so take them all from the single
+		// closest report for each year.
+		naByYear := map[int][]api.NonAuditServiceContract{}
+		for _, n := range src.NonAudit {
+			y := RelativeYear(n.BsnsYear, src.Year)
+			naByYear[y] = append(naByYear[y], n)
+		}
+		for y, rows := range naByYear {
+			s := get(y)
+			if off := src.Year - y; off < s.naOff {
+				s.naOff = off
+				s.rec.NonAuditFee = 0
+				s.rec.NonAuditCount = 0
+				for _, n := range rows {
+					if strings.TrimSpace(n.Service) == "" || n.Service == "-" {
+						continue
+					}
+					s.rec.NonAuditCount++
+					if v, ok := ParseAmount(leadingNumber(n.Fee)); ok {
+						s.rec.NonAuditFee += v
+					}
+				}
+			}
+		}
+	}
+
+	out := make([]AuditYear, 0, len(years))
+	for _, s := range years {
+		out = append(out, s.rec)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Year < out[j].Year })
+
+	prev := ""
+	for i := range out {
+		out[i].Modified = !IsUnqualifiedOpinion(out[i].Opinion)
+		if out[i].Auditor != "" {
+			if prev != "" && normalizeAuditor(out[i].Auditor) != normalizeAuditor(prev) {
+				out[i].AuditorChanged = true
+			}
+			prev = out[i].Auditor
+		}
+	}
+	return out
+}
+
+// AuditMarkdown renders the auditor/opinion history and fee table.
+func AuditMarkdown(corpName string, years []AuditYear) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 외부감사 현황\n\n", corpName)
+
+	changes, modified := 0, 0
+	for _, y := range years {
+		if y.AuditorChanged {
+			changes++
+		}
+		if y.Modified {
+			modified++
+		}
+	}
+	fmt.Fprintf(&sb, "감사인 변경 **%d**회 · 비적정 의견 **%d**건\n\n", changes, modified)
+
+	sb.WriteString("## 감사인 및 감사의견\n\n")
+	sb.WriteString("| 사업연도 | 감사인 | 감사의견 | 강조사항 |\n")
+	sb.WriteString("|----------|--------|----------|----------|\n")
+	for _, y := range years {
+		auditor := dash(y.Auditor)
+		if y.AuditorChanged {
+			auditor = "**" + auditor + "** (변경)"
+		}
+		opinion := dash(y.Opinion)
+		if y.Modified {
+			opinion = "**" + opinion + "** ⚠"
+		}
+		fmt.Fprintf(&sb, "| %d | %s | %s | %s |\n", y.Year, auditor, opinion, dash(y.EmphasisMatter))
+	}
+	sb.WriteString("\n")
+
+	hasKAM := false
+	for _, y := range years {
+		if y.KeyAuditMatters != "" && y.KeyAuditMatters != "-" {
+			hasKAM = true
+			break
+		}
+	}
+	if hasKAM {
+		sb.WriteString("## 핵심감사사항\n\n")
+		for i := len(years) - 1; i >= 0; i-- {
+			y := years[i]
+			if y.KeyAuditMatters == "" || y.KeyAuditMatters == "-" {
+				continue
+			}
+			fmt.Fprintf(&sb, "- **%d**: %s\n", y.Year, y.KeyAuditMatters)
+		}
+		sb.WriteString("\n")
+	}
+
+	sb.WriteString("## 감사보수\n\n")
+	sb.WriteString("| 사업연도 | 감사계약 보수 | 계약 시간 | 실제 보수 | 실제 시간 | 비감사용역 | 비감사 보수 |\n")
+	sb.WriteString("|----------|---:|---:|---:|---:|---:|---:|\n")
+	for _, y := range years {
+		nonAudit := "-"
+		if y.NonAuditFee > 0 {
+			nonAudit = commaInt(y.NonAuditFee)
+		}
+		fmt.Fprintf(&sb, "| %d | %s | %s | %s | %s | %d건 | %s |\n",
+			y.Year, dash(y.ContractFee), dash(y.ContractHours),
+			dash(y.ActualFee), dash(y.ActualHours), y.NonAuditCount, nonAudit)
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// normalizeAuditor strips spacing and legal-form noise so that
+// "삼일회계법인" and "삼일 회계법인" compare equal.
+func normalizeAuditor(s string) string {
+	s = strings.ReplaceAll(s, " ", "")
+	s = strings.ReplaceAll(s, "(유)", "")
+	s = strings.ReplaceAll(s, "유한회사", "")
+	return s
+}
+
+var leadingNumberRe = regexp.MustCompile(`-?[\d,]+`)
+
+// leadingNumber extracts the first number from strings like "120백만원".
+func leadingNumber(s string) string {
+	return leadingNumberRe.FindString(s)
+}
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v = strings.TrimSpace(v); v != "" && v != "-" {
+			return v
+		}
+	}
+	return ""
+}
+
+// dash returns "-" for empty strings so table cells never collapse.
+func dash(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return "-"
+	}
+	return s
+}
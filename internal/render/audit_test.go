@@ -0,0 +1,73 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestRelativeYear(t *testing.T) {
+	cases := []struct {
+		label string
+		want  int
+	}{
+		{"제55기(당기)", 2024},
+		{"제54기(전기)", 2023},
+		{"제53기(전전기)", 2022},
+		{"2021년", 2021},
+	}
+	for _, c := range cases {
+		if got := RelativeYear(c.label, 2024); got != c.want {
+			t.Errorf("RelativeYear(%q) = %d, want %d", c.label, got, c.want)
+		}
+	}
+}
+
+func TestIsUnqualifiedOpinion(t *testing.T) {
+	cases := map[string]bool{
+		"적정":    true,
+		"적정의견":  true,
+		"-":     true,
+		"한정":    false,
+		"부적정":   false,
+		"의견 거절": false,
+		"한정의견":  false,
+	}
+	for op, want := range cases {
+		if got := IsUnqualifiedOpinion(op); got != want {
+			t.Errorf("IsUnqualifiedOpinion(%q) = %v, want %v", op, got, want)
+		}
+	}
+}
+
+func TestAuditHistory_감사인변경_비적정(t *testing.T) {
+	sources := []AuditSource{
+		{Year: 2024, Opinions: []api.AuditOpinion{
+			{BsnsYear: "제3기(당기)", Auditor: "삼정회계법인", Opinion: "한정"},
+			{BsnsYear: "제2기(전기)", Auditor: "삼일회계법인", Opinion: "적정"},
+		}},
+		{Year: 2023, Opinions: []api.AuditOpinion{
+			{BsnsYear: "제2기(당기)", Auditor: "삼일 회계법인", Opinion: "적정"},
+			{BsnsYear: "제1기(전기)", Auditor: "삼일회계법인", Opinion: "적정"},
+		}, NonAudit: []api.NonAuditServiceContract{
+			{BsnsYear: "제2기(당기)", Service: "세무자문", Fee: "30"},
+			{BsnsYear: "제2기(당기)", Service: "실사", Fee: "1,200"},
+		}},
+	}
+	years := AuditHistory(sources)
+	if len(years) != 3 {
+		t.Fatalf("3개 연도 기대, got %d", len(years))
+	}
+	if years[1].Auditor != "삼일 회계법인" {
+		t.Errorf("2023년은 자기 보고서 당기 행을 사용해야 함: got %q", years[1].Auditor)
+	}
+	if years[1].AuditorChanged {
+		t.Error("공백 차이는 감사인 변경이 아님")
+	}
+	if !years[2].AuditorChanged || !years[2].Modified {
+		t.Errorf("2024년: 감사인 변경·비적정 기대, got %+v", years[2])
+	}
+	if years[1].NonAuditCount != 2 || years[1].NonAuditFee != 1230 {
+		t.Errorf("2023년 비감사용역: 2건/1230 기대, got %d건/%d", years[1].NonAuditCount, years[1].NonAuditFee)
+	}
+}
@@ -0,0 +1,29 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/cache"
+)
+
+// CacheStatusMarkdown renders the corp code cache status.
+func CacheStatusMarkdown(s cache.StatusInfo) string {
+	var sb strings.Builder
+	sb.WriteString("# 캐시 상태\n\n")
+
+	if !s.Exists {
+		sb.WriteString("캐시 파일이 없습니다. `dartcli cache refresh`로 초기화하세요.\n")
+		return sb.String()
+	}
+
+	staleLabel := "최신"
+	if s.Stale {
+		staleLabel = "**갱신 필요**"
+	}
+	fmt.Fprintf(&sb, "| 항목 | 값 |\n|------|----|\n")
+	fmt.Fprintf(&sb, "| 파일 | `%s` |\n", s.Path)
+	fmt.Fprintf(&sb, "| 최종 갱신 | %s |\n", s.UpdatedAt.Format("2006-01-02 15:04:05"))
+	fmt.Fprintf(&sb, "| 상태 | %s |\n", staleLabel)
+	return sb.String()
+}
@@ -0,0 +1,206 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// CapitalEvent is a normalised 유상증자/무상증자/유무상증자/감자 decision.
+type CapitalEvent struct {
+	Type       string    `json:"type"`
+	RceptNo    string    `json:"rcept_no"`
+	FiledDate  string    `json:"filed_date"`  // YYYYMMDD, from the 접수번호
+	NewShares  int64     `json:"new_shares"`  // negative for 감자
+	PreShares  int64     `json:"pre_shares"`  // 발행주식총수(보통주) before the event
+	IssuePrice int64     `json:"issue_price"` // implied: funds raised / new shares (유상 only)
+	FundsTotal int64     `json:"funds_total"`
+	Method     string    `json:"method"`
+	Purposes   []FundUse `json:"purposes"`
+	RecordDate string    `json:"record_date"`
+	Dilution   float64   `json:"dilution"` // NewShares / PreShares * 100
+	Note       string    `json:"note"`
+}
+
+// FundUse is one 자금조달의 목적 line.
+type FundUse struct {
+	Purpose string `json:"purpose"`
+	Amount  int64  `json:"amount"`
+}
+
+// FundUses converts the fdpp_* breakdown into non-zero purpose lines.
+func FundUses(f api.FundPurposes) []FundUse {
+	return fundUses(f.Facility, f.Acquisition, f.Operation, f.DebtRepay, f.Securities, f.Etc)
+}
+
+func fundUses(facility, acquisition, operation, debtRepay, securities, etc string) []FundUse {
+	var out []FundUse
+	for _, u := range []struct{ label, amount string }{
+		{"시설자금", facility},
+		{"영업양수자금", acquisition},
+		{"운영자금", operation},
+		{"채무상환자금", debtRepay},
+		{"타법인증권 취득자금", securities},
+		{"기타자금", etc},
+	} {
+		if v, ok := ParseAmount(u.amount); ok && v != 0 {
+			out = append(out, FundUse{Purpose: u.label, Amount: v})
+		}
+	}
+	return out
+}
+
+// SetPreShares records the pre-event share count when the filing did not
+// state one, and derives the dilution ratio.
+func (e *CapitalEvent) SetPreShares(n int64) {
+	if e.PreShares == 0 {
+		e.PreShares = n
+	}
+	if e.PreShares > 0 {
+		e.Dilution = float64(e.NewShares) / float64(e.PreShares) * 100
+	}
+}
+
+func capitalEvent(typ string, h api.ReportHeader) CapitalEvent {
+	filed := ""
+	if len(h.RceptNo) >= 8 {
+		filed = h.RceptNo[:8]
+	}
+	return CapitalEvent{Type: typ, RceptNo: h.RceptNo, FiledDate: filed}
+}
+
+func amount(s string) int64 {
+	v, _ := ParseAmount(s)
+	return v
+}
+
+// CapitalEvents normalises every capital decision into one list, newest first.
+func CapitalEvents(paid []api.PaidInCapitalDecision, bonus []api.BonusIssueDecision,
+	mixed []api.MixedCapitalDecision, reductions []api.CapitalReductionDecision) []CapitalEvent {
+	var out []CapitalEvent
+
+	for _, r := range paid {
+		e := capitalEvent("유상증자", r.ReportHeader)
+		e.NewShares = amount(r.NewCommon)
+		e.PreShares = amount(r.PreCommon)
+		e.Method = collapse(r.Method)
+		e.Purposes = FundUses(r.FundPurposes)
+		e.FundsTotal = sumFunds(e.Purposes)
+		if newTotal := e.NewShares + amount(r.NewOther); newTotal > 0 {
+			e.IssuePrice = e.FundsTotal / newTotal
+		}
+		out = append(out, e)
+	}
+	for _, r := range bonus {
+		e := capitalEvent("무상증자", r.ReportHeader)
+		e.NewShares = amount(r.NewCommon)
+		e.PreShares = amount(r.PreCommon)
+		e.Method = "무상"
+		e.RecordDate = r.RecordDate
+		if s := strings.TrimSpace(r.PerShareCommon); s != "" && s != "-" {
+			e.Note = "1주당 " + s + "주 배정"
+		}
+		out = append(out, e)
+	}
+	for _, r := range mixed {
+		e := capitalEvent("유무상증자", r.ReportHeader)
+		paidShares, bonusShares := amount(r.PaidNewCommon), amount(r.BonusNewCommon)
+		e.NewShares = paidShares + bonusShares
+		e.PreShares = amount(firstNonEmpty(r.PaidPreCommon, r.BonusPreCommon))
+		e.Method = joinNonEmpty(collapse(r.PaidMethod), "무상")
+		e.Purposes = fundUses(r.PaidFacility, r.PaidAcquisition, r.PaidOperation, r.PaidDebtRepay, r.PaidSecurities, r.PaidEtc)
+		e.FundsTotal = sumFunds(e.Purposes)
+		if paidShares > 0 {
+			e.IssuePrice = e.FundsTotal / paidShares
+		}
+		e.RecordDate = r.BonusRecordDate
+		e.Note = fmt.Sprintf("유상 %s주 + 무상 %s주", commaInt(paidShares), commaInt(bonusShares))
+		out = append(out, e)
+	}
+	for _, r := range reductions {
+		e := capitalEvent("감자", r.ReportHeader)
+		e.NewShares = -amount(r.ReducedCommon)
+		e.PreShares = amount(r.PreCommon)
+		e.Method = collapse(r.Method)
+		e.RecordDate = r.RecordDate
+		e.Note = joinNonEmpty(labelled("감자비율", pct(r.RatioCommon)), collapse(r.Reason))
+		out = append(out, e)
+	}
+
+	// Derive dilution from the share count stated in each filing.
+	for i := range out {
+		out[i].SetPreShares(0)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].RceptNo > out[j].RceptNo })
+	return out
+}
+
+func sumFunds(uses []FundUse) int64 {
+	var total int64
+	for _, u := range uses {
+		total += u.Amount
+	}
+	return total
+}
+
+// CapitalMarkdown renders capital decisions as a summary table followed by
+// the purpose breakdown of each paid-in offering.
+func CapitalMarkdown(corpName, startDate, endDate string, events []CapitalEvent) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 증자·감자 결정\n\n", corpName)
+	fmt.Fprintf(&sb, "%s ~ %s · 총 **%d**건\n\n", FormatDate(startDate), FormatDate(endDate), len(events))
+
+	sb.WriteString("| 접수일 | 구분 | 방식 | 신주(보통주) | 기존 주식수 | 희석률 | 발행가(추정) | 조달금액 | 기준일 |\n")
+	sb.WriteString("|--------|------|------|---:|---:|---:|---:|---:|--------|\n")
+	for _, e := range events {
+		price := "-"
+		if e.IssuePrice > 0 {
+			price = commaInt(e.IssuePrice) + "원"
+		}
+		funds := "-"
+		if e.FundsTotal > 0 {
+			funds = FormatAmount(fmt.Sprint(e.FundsTotal)) + "원"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			FormatDate(e.FiledDate), e.Type, dash(e.Method), signedInt(e.NewShares),
+			amountCell(e.PreShares), signedPercent(e.Dilution), price, funds, dash(e.RecordDate))
+	}
+	sb.WriteString("\n")
+
+	for _, e := range events {
+		if len(e.Purposes) == 0 && e.Note == "" {
+			continue
+		}
+		fmt.Fprintf(&sb, "## %s %s\n\n", FormatDate(e.FiledDate), e.Type)
+		if e.Note != "" {
+			fmt.Fprintf(&sb, "%s\n\n", e.Note)
+		}
+		if len(e.Purposes) > 0 {
+			sb.WriteString("| 자금용도 | 금액 | 비중 |\n|----------|---:|---:|\n")
+			for _, u := range e.Purposes {
+				share := 0.0
+				if e.FundsTotal > 0 {
+					share = float64(u.Amount) / float64(e.FundsTotal) * 100
+				}
+				fmt.Fprintf(&sb, "| %s | %s원 | %.1f%% |\n", u.Purpose, FormatAmount(fmt.Sprint(u.Amount)), share)
+			}
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "원문: [%s](%s)\n\n", e.RceptNo, DARTViewURL(e.RceptNo))
+	}
+	return sb.String()
+}
+
+func signedPercent(v float64) string {
+	switch {
+	case v > 0:
+		return fmt.Sprintf("+%.2f%%", v)
+	case v < 0:
+		return fmt.Sprintf("%.2f%%", v)
+	default:
+		return "-"
+	}
+}
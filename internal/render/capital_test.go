@@ -0,0 +1,47 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestCapitalEvents(t *testing.T) {
+	paid := []api.PaidInCapitalDecision{{
+		ReportHeader: api.ReportHeader{RceptNo: "20240301000123"},
+		FundPurposes: api.FundPurposes{Facility: "6,000,000,000", Operation: "4,000,000,000"},
+		NewCommon:    "1,000,000",
+		PreCommon:    "10,000,000",
+		Method:       "제3자배정증자",
+	}}
+	reductions := []api.CapitalReductionDecision{{
+		ReportHeader:  api.ReportHeader{RceptNo: "20230510000456"},
+		ReducedCommon: "5,000,000",
+		PreCommon:     "20,000,000",
+		RatioCommon:   "25",
+	}}
+
+	events := CapitalEvents(paid, nil, nil, reductions)
+	if len(events) != 2 || events[0].Type != "유상증자" {
+		t.Fatalf("최신순 2건 기대, got %+v", events)
+	}
+
+	e := events[0]
+	if e.FiledDate != "20240301" || e.FundsTotal != 10_000_000_000 || e.IssuePrice != 10_000 {
+		t.Errorf("유상증자 정규화 오류: %+v", e)
+	}
+	if e.Dilution != 10 || len(e.Purposes) != 2 || e.Purposes[0].Purpose != "시설자금" {
+		t.Errorf("희석률/자금용도 오류: %+v", e)
+	}
+	if events[1].NewShares != -5_000_000 || events[1].Dilution != -25 {
+		t.Errorf("감자는 음수 희석률: %+v", events[1])
+	}
+}
+
+func TestCapitalEvent_SetPreShares_공시값우선(t *testing.T) {
+	e := CapitalEvent{NewShares: 100, PreShares: 1000}
+	e.SetPreShares(5000)
+	if e.PreShares != 1000 || e.Dilution != 10 {
+		t.Errorf("공시에 기재된 주식수를 유지해야 함: %+v", e)
+	}
+}
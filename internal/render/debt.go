@@ -0,0 +1,312 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// LadderBuckets are the consolidated maturity columns shared by every
+// instrument. Each instrument's native buckets fold into one of these.
+var LadderBuckets = []string{"1년 이하", "1~5년", "5~10년", "10년 초과"}
+
+const (
+	ladder1Y = iota
+	ladder5Y
+	ladder10Y
+	ladderOver10Y
+)
+
+// MaturityBucket is one native remaining-maturity bucket of an instrument.
+type MaturityBucket struct {
+	Label  string `json:"label"`
+	Ladder int    `json:"ladder"` // index into LadderBuckets
+	Amount int64  `json:"amount"`
+}
+
+// DebtInstrument is the outstanding balance of one instrument type.
+type DebtInstrument struct {
+	Name    string           `json:"name"`
+	Buckets []MaturityBucket `json:"buckets"`
+	Ladder  []int64          `json:"ladder"` // amounts per LadderBuckets entry
+	Total   int64            `json:"total"`
+	Note    string           `json:"note"`
+}
+
+// balanceRow is a single 공모/사모/합계 row before consolidation.
+type balanceRow struct {
+	kind    string
+	buckets []MaturityBucket
+	total   string
+}
+
+func bucket(label string, ladder int, amount string) MaturityBucket {
+	v, _ := ParseAmount(amount)
+	return MaturityBucket{Label: label, Ladder: ladder, Amount: v}
+}
+
+// BondInstrument normalises 회사채 미상환 잔액 rows.
+func BondInstrument(rows []api.BondBalance) DebtInstrument {
+	var br []balanceRow
+	for _, r := range rows {
+		br = append(br, balanceRow{kind: r.Kind, total: r.Total, buckets: []MaturityBucket{
+			bucket("1년 이하", ladder1Y, r.Y1Below),
+			bucket("1~2년", ladder5Y, r.Y1To2),
+			bucket("2~3년", ladder5Y, r.Y2To3),
+			bucket("3~4년", ladder5Y, r.Y3To4),
+			bucket("4~5년", ladder5Y, r.Y4To5),
+			bucket("5~10년", ladder10Y, r.Y5To10),
+			bucket("10년 초과", ladderOver10Y, r.Y10Over),
+		}})
+	}
+	return newInstrument("회사채", br)
+}
+
+// CommercialPaperInstrument normalises 기업어음증권 미상환 잔액 rows.
+// The open-ended "3년 초과" bucket is folded into 1~5년, since CP
+// maturities beyond five years do not occur in practice.
+func CommercialPaperInstrument(rows []api.CommercialPaperBalance) DebtInstrument {
+	var br []balanceRow
+	for _, r := range rows {
+		br = append(br, balanceRow{kind: r.Kind, total: r.Total, buckets: []MaturityBucket{
+			bucket("10일 이하", ladder1Y, r.D10Below),
+			bucket("10~30일", ladder1Y, r.D10To30),
+			bucket("30~90일", ladder1Y, r.D30To90),
+			bucket("90~180일", ladder1Y, r.D90To180),
+			bucket("180일~1년", ladder1Y, r.D180ToY1),
+			bucket("1~2년", ladder5Y, r.Y1To2),
+			bucket("2~3년", ladder5Y, r.Y2To3),
+			bucket("3년 초과", ladder5Y, r.Y3Over),
+		}})
+	}
+	return newInstrument("기업어음증권", br)
+}
+
+// ShortTermBondInstrument normalises 단기사채 미상환 잔액 rows.
+func ShortTermBondInstrument(rows []api.ShortTermBondBalance) DebtInstrument {
+	var br []balanceRow
+	var limit, remaining string
+	for _, r := range rows {
+		br = append(br, balanceRow{kind: r.Kind, total: r.Total, buckets: []MaturityBucket{
+			bucket("10일 이하", ladder1Y, r.D10Below),
+			bucket("10~30일", ladder1Y, r.D10To30),
+			bucket("30~90일", ladder1Y, r.D30To90),
+			bucket("90~180일", ladder1Y, r.D90To180),
+			bucket("180일~1년", ladder1Y, r.D180ToY1),
+		}})
+		if isTotalKind(r.Kind) || limit == "" {
+			limit, remaining = r.IssueLimit, r.RemainingLimit
+		}
+	}
+	inst := newInstrument("단기사채", br)
+	if _, ok := ParseAmount(limit); ok {
+		inst.Note = fmt.Sprintf("발행한도 %s / 잔여한도 %s", FormatAmountKRW(limit), FormatAmountKRW(remaining))
+	}
+	return inst
+}
+
+// HybridCapitalInstrument normalises 신종자본증권 미상환 잔액 rows.
+func HybridCapitalInstrument(rows []api.HybridCapitalBalance) DebtInstrument {
+	var br []balanceRow
+	for _, r := range rows {
+		br = append(br, balanceRow{kind: r.Kind, total: r.Total, buckets: []MaturityBucket{
+			bucket("1년 이하", ladder1Y, r.Y1Below),
+			bucket("1~5년", ladder5Y, r.Y1To5),
+			bucket("5~10년", ladder10Y, r.Y5To10),
+			bucket("10~15년", ladderOver10Y, r.Y10To15),
+			bucket("15~20년", ladderOver10Y, r.Y15To20),
+			bucket("20~30년", ladderOver10Y, r.Y20To30),
+			bucket("30년 초과", ladderOver10Y, r.Y30Over),
+		}})
+	}
+	return newInstrument("신종자본증권", br)
+}
+
+// ContingentCapitalInstrument normalises 조건부자본증권 미상환 잔액 rows.
+func ContingentCapitalInstrument(rows []api.ContingentCapitalBalance) DebtInstrument {
+	var br []balanceRow
+	for _, r := range rows {
+		br = append(br, balanceRow{kind: r.Kind, total: r.Total, buckets: []MaturityBucket{
+			bucket("1년 이하", ladder1Y, r.Y1Below),
+			bucket("1~2년", ladder5Y, r.Y1To2),
+			bucket("2~3년", ladder5Y, r.Y2To3),
+			bucket("3~4년", ladder5Y, r.Y3To4),
+			bucket("4~5년", ladder5Y, r.Y4To5),
+			bucket("5~10년", ladder10Y, r.Y5To10),
+			bucket("10~20년", ladderOver10Y, r.Y10To20),
+			bucket("20~30년", ladderOver10Y, r.Y20To30),
+			bucket("30년 초과", ladderOver10Y, r.Y30Over),
+		}})
+	}
+	return newInstrument("조건부자본증권", br)
+}
+
+func isTotalKind(kind string) bool {
+	return strings.Contains(kind, "합계") || strings.TrimSpace(kind) == "계"
+}
+
+// newInstrument consolidates 공모/사모/합계 rows into one balance. The 합계
+// row is used when present; otherwise the remaining rows are summed.
+func newInstrument(name string, rows []balanceRow) DebtInstrument {
+	inst := DebtInstrument{Name: name, Ladder: make([]int64, len(LadderBuckets))}
+	if len(rows) == 0 {
+		return inst
+	}
+
+	selected := rows
+	for _, r := range rows {
+		if isTotalKind(r.kind) {
+			selected = []balanceRow{r}
+			break
+		}
+	}
+
+	inst.Buckets = make([]MaturityBucket, len(selected[0].buckets))
+	copy(inst.Buckets, selected[0].buckets)
+	for i := range inst.Buckets {
+		inst.Buckets[i].Amount = 0
+	}
+	var reportedTotal int64
+	for _, r := range selected {
+		for i, b := range r.buckets {
+			inst.Buckets[i].Amount += b.Amount
+		}
+		if v, ok := ParseAmount(r.total); ok {
+			reportedTotal += v
+		}
+	}
+	for _, b := range inst.Buckets {
+		inst.Ladder[b.Ladder] += b.Amount
+		inst.Total += b.Amount
+	}
+	if inst.Total == 0 {
+		inst.Total = reportedTotal
+	}
+	return inst
+}
+
+// DebtMarkdown renders outstanding balances as a maturity ladder followed by
+// per-instrument detail and the issuance history grouped by security type.
+func DebtMarkdown(corpName, year, periodLabel string, instruments []DebtInstrument, issues []api.DebtIssuance) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 채무증권 현황\n\n", corpName)
+	fmt.Fprintf(&sb, "**%s년 %s 보고서 기준** · 금액은 보고서 기재 단위 그대로 표시합니다.\n\n", year, periodLabel)
+
+	// ── maturity ladder ──────────────────────────────────────────────────────
+	sb.WriteString("## 만기별 미상환 잔액\n\n")
+	sb.WriteString("| 구분 | " + strings.Join(LadderBuckets, " | ") + " | 합계 |\n")
+	sb.WriteString("|------|" + strings.Repeat("---:|", len(LadderBuckets)) + "---:|\n")
+
+	sums := make([]int64, len(LadderBuckets))
+	var grand int64
+	for _, inst := range instruments {
+		fmt.Fprintf(&sb, "| %s |", inst.Name)
+		for i, v := range inst.Ladder {
+			fmt.Fprintf(&sb, " %s |", amountCell(v))
+			sums[i] += v
+		}
+		fmt.Fprintf(&sb, " %s |\n", amountCell(inst.Total))
+		grand += inst.Total
+	}
+	sb.WriteString("| **합계** |")
+	for _, v := range sums {
+		fmt.Fprintf(&sb, " **%s** |", amountCell(v))
+	}
+	fmt.Fprintf(&sb, " **%s** |\n\n", amountCell(grand))
+
+	if grand > 0 {
+		sb.WriteString("| 구간 | 비중 |\n|------|---:|\n")
+		for i, label := range LadderBuckets {
+			fmt.Fprintf(&sb, "| %s | %.1f%% |\n", label, float64(sums[i])/float64(grand)*100)
+		}
+		sb.WriteString("\n")
+	}
+
+	// ── per-instrument detail ────────────────────────────────────────────────
+	for _, inst := range instruments {
+		if inst.Total == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "### %s\n\n", inst.Name)
+		sb.WriteString("| 잔여만기 | 금액 |\n|----------|---:|\n")
+		for _, b := range inst.Buckets {
+			fmt.Fprintf(&sb, "| %s | %s |\n", b.Label, amountCell(b.Amount))
+		}
+		sb.WriteString("\n")
+		if inst.Note != "" {
+			fmt.Fprintf(&sb, "%s\n\n", inst.Note)
+		}
+	}
+
+	// ── issuance history ─────────────────────────────────────────────────────
+	sb.WriteString("## 채무증권 발행실적\n\n")
+	if len(issues) == 0 {
+		sb.WriteString("발행실적이 없습니다.\n\n")
+		return sb.String()
+	}
+
+	type typeSum struct {
+		count  int
+		amount int64
+	}
+	byType := map[string]*typeSum{}
+	var order []string
+	for _, it := range issues {
+		key := it.SecurityType
+		if key == "" {
+			key = "-"
+		}
+		if byType[key] == nil {
+			byType[key] = &typeSum{}
+			order = append(order, key)
+		}
+		byType[key].count++
+		if v, ok := ParseAmount(it.FaceValue); ok {
+			byType[key].amount += v
+		}
+	}
+	sb.WriteString("| 증권종류 | 건수 | 권면총액 |\n|----------|---:|---:|\n")
+	for _, key := range order {
+		fmt.Fprintf(&sb, "| %s | %d | %s |\n", key, byType[key].count, amountCell(byType[key].amount))
+	}
+	sb.WriteString("\n")
+
+	sorted := make([]api.DebtIssuance, len(issues))
+	copy(sorted, issues)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return digitsOnly(sorted[i].IssueDate) > digitsOnly(sorted[j].IssueDate)
+	})
+
+	sb.WriteString("| 발행일 | 증권종류 | 발행방법 | 권면총액 | 이자율 | 신용등급 | 만기일 | 상환여부 | 주관회사 |\n")
+	sb.WriteString("|--------|----------|----------|---:|---:|----------|--------|----------|----------|\n")
+	for _, it := range sorted {
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			it.IssueDate, it.SecurityType, it.IssueMethod,
+			FormatAmountKRW(it.FaceValue), it.InterestRate, it.Rating,
+			it.Maturity, it.Redeemed, it.Underwriter)
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// amountCell formats an aggregated amount, showing "-" for zero.
+func amountCell(v int64) string {
+	if v == 0 {
+		return "-"
+	}
+	return commaInt(v)
+}
+
+// digitsOnly strips separators so dates like "2024.03.15" sort as 20240315.
+func digitsOnly(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
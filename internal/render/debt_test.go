@@ -0,0 +1,46 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestBondInstrument_합계행우선(t *testing.T) {
+	rows := []api.BondBalance{
+		{Kind: "공모", Y1Below: "100", Y2To3: "50", Total: "150"},
+		{Kind: "사모", Y1Below: "10", Total: "10"},
+		{Kind: "합계", Y1Below: "110", Y2To3: "50", Y5To10: "-", Y10Over: "1,000", Total: "1,160"},
+	}
+	inst := BondInstrument(rows)
+	want := []int64{110, 50, 0, 1000}
+	for i, v := range want {
+		if inst.Ladder[i] != v {
+			t.Errorf("ladder[%s] = %d, want %d", LadderBuckets[i], inst.Ladder[i], v)
+		}
+	}
+	if inst.Total != 1160 {
+		t.Errorf("total = %d, want 1160", inst.Total)
+	}
+}
+
+func TestHybridCapitalInstrument_합계행없으면합산(t *testing.T) {
+	rows := []api.HybridCapitalBalance{
+		{Kind: "공모", Y1To5: "200", Y30Over: "300"},
+		{Kind: "사모", Y1To5: "20", Y10To15: "5"},
+	}
+	inst := HybridCapitalInstrument(rows)
+	want := []int64{0, 220, 0, 305}
+	for i, v := range want {
+		if inst.Ladder[i] != v {
+			t.Errorf("ladder[%s] = %d, want %d", LadderBuckets[i], inst.Ladder[i], v)
+		}
+	}
+}
+
+func TestNewInstrument_빈결과(t *testing.T) {
+	inst := CommercialPaperInstrument(nil)
+	if inst.Total != 0 || len(inst.Ladder) != len(LadderBuckets) {
+		t.Fatalf("빈 입력: total=%d ladder=%v", inst.Total, inst.Ladder)
+	}
+}
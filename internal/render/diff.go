@@ -0,0 +1,636 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Section diff statuses.
+const (
+	DiffAdded     = "added"
+	DiffRemoved   = "removed"
+	DiffChanged   = "changed"
+	DiffUnchanged = "unchanged"
+)
+
+// Diff line kinds. A change line pairs a removed line with the added line
+// that replaced it and carries the word-level edits between them.
+const (
+	LineEqual  = "equal"
+	LineDelete = "delete"
+	LineInsert = "insert"
+	LineChange = "change"
+)
+
+// DefaultDiffThreshold is the share of changed words above which a
+// section counts as materially changed.
+const DefaultDiffThreshold = 0.05
+
+// diffContext is how many unchanged lines are shown around changes.
+const diffContext = 2
+
+// DocumentDiff is diff output: the sections of two filings aligned by
+// section path, in the order of the newer one.
+type DocumentDiff struct {
+	Old       string        `json:"old"`
+	New       string        `json:"new"`
+	Threshold float64       `json:"threshold"`
+	Sections  []SectionDiff `json:"sections"`
+}
+
+// SectionDiff compares one section's own content (without subsections).
+// Change is the share of words removed or added.
+type SectionDiff struct {
+	Path     string     `json:"path"`
+	OldPath  string     `json:"old_path,omitempty"`
+	Status   string     `json:"status"`
+	Change   float64    `json:"change"`
+	Material bool       `json:"material"`
+	Lines    []DiffLine `json:"lines,omitempty"`
+}
+
+// DiffLine is one line of a section diff.
+type DiffLine struct {
+	Kind  string   `json:"kind"`
+	Old   string   `json:"old,omitempty"`
+	New   string   `json:"new,omitempty"`
+	Words []DiffOp `json:"words,omitempty"`
+}
+
+// DiffOp is a run of words that is equal in both lines, removed or added.
+type DiffOp struct {
+	Kind string `json:"kind"`
+	Text string `json:"text"`
+}
+
+// diffEntry is a section flattened for alignment.
+type diffEntry struct {
+	path  string
+	key   string // normalised path
+	loose string // normalised path without heading markers
+	lines []string
+}
+
+// flattenForDiff lists the cover and every section of docs with the text
+// lines of its own blocks. Table rows become " | "-joined lines so a
+// changed figure shows up as a changed word.
+func flattenForDiff(docs []*Document) []diffEntry {
+	var out []diffEntry
+	for _, d := range docs {
+		prefix := ""
+		if len(docs) > 1 {
+			prefix = d.Title + " > "
+		}
+		add := func(path string, blocks []Block) {
+			out = append(out, diffEntry{
+				path:  prefix + path,
+				key:   diffKey(prefix+path, false),
+				loose: diffKey(prefix+path, true),
+				lines: blockLines(blocks),
+			})
+		}
+		cover := append(append([]Block{}, d.Cover...), d.Blocks...)
+		if len(cover) > 0 {
+			add("표지", cover)
+		}
+		var walk func(secs []*Section)
+		walk = func(secs []*Section) {
+			for _, s := range secs {
+				add(s.Path, s.Blocks)
+				walk(s.Sections)
+			}
+		}
+		walk(d.Sections)
+	}
+	return out
+}
+
+// diffKey normalises a section path for alignment. loose drops each
+// part's heading marker, so "3. 주요 제품" matches a renumbered "2. 주요 제품".
+func diffKey(path string, loose bool) string {
+	parts := strings.Split(path, " > ")
+	for i, p := range parts {
+		p = normTitle(p)
+		if loose {
+			if f := strings.Fields(p); len(f) > 1 && markerSpec.MatchString(f[0]) {
+				p = strings.Join(f[1:], " ")
+			}
+		}
+		parts[i] = strings.ReplaceAll(p, " ", "")
+	}
+	return strings.Join(parts, ">")
+}
+
+// blockLines turns blocks into diffable text lines.
+func blockLines(blocks []Block) []string {
+	var lines []string
+	for _, b := range blocks {
+		if b.Kind != BlockTable {
+			if text := collapse(b.Text); text != "" {
+				lines = append(lines, text)
+			}
+			continue
+		}
+		if b.Table == nil {
+			continue
+		}
+		l := b.Table.Layout()
+		for _, row := range append([][]string{l.Header}, l.Rows...) {
+			var cells []string
+			for _, c := range row {
+				cells = append(cells, collapse(c))
+			}
+			if line := strings.Join(cells, " | "); strings.Trim(line, " |") != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+	return lines
+}
+
+// DiffDocuments aligns the sections of two parsed filings by path and
+// diffs each pair word by word. Sections whose share of changed words
+// reaches threshold are marked Material.
+func DiffDocuments(oldLabel, newLabel string, oldDocs, newDocs []*Document, threshold float64) *DocumentDiff {
+	a, b := flattenForDiff(oldDocs), flattenForDiff(newDocs)
+	match := alignSections(a, b)
+
+	dd := &DocumentDiff{Old: oldLabel, New: newLabel, Threshold: threshold}
+	used := make([]bool, len(a))
+	for _, i := range match {
+		used[i] = true
+	}
+	next := 0 // first old section not yet placed
+	flushRemoved := func(upto int) {
+		for ; next < upto; next++ {
+			if !used[next] {
+				used[next] = true
+				dd.Sections = append(dd.Sections, SectionDiff{
+					Path: a[next].path, Status: DiffRemoved, Change: 1, Material: len(a[next].lines) > 0,
+					Lines: linesOf(LineDelete, a[next].lines),
+				})
+			}
+		}
+	}
+	for j, e := range b {
+		i, ok := match[j]
+		if !ok {
+			dd.Sections = append(dd.Sections, SectionDiff{
+				Path: e.path, Status: DiffAdded, Change: 1, Material: len(e.lines) > 0,
+				Lines: linesOf(LineInsert, e.lines),
+			})
+			continue
+		}
+		// Removed sections are shown where they stood before this one.
+		flushRemoved(i)
+		sd := diffSection(a[i].lines, e.lines)
+		sd.Path = e.path
+		if a[i].path != e.path {
+			sd.OldPath = a[i].path
+		}
+		sd.Material = sd.Status == DiffChanged && sd.Change >= threshold
+		dd.Sections = append(dd.Sections, sd)
+	}
+	flushRemoved(len(a))
+	return dd
+}
+
+// alignSections maps new section index to old section index, first by
+// exact path and then, for what is left, by path without markers.
+func alignSections(a, b []diffEntry) map[int]int {
+	match := map[int]int{}
+	taken := make([]bool, len(a))
+	for _, loose := range []bool{false, true} {
+		byKey := map[string][]int{}
+		for i, e := range a {
+			if !taken[i] {
+				k := e.key
+				if loose {
+					k = e.loose
+				}
+				byKey[k] = append(byKey[k], i)
+			}
+		}
+		for j, e := range b {
+			if _, ok := match[j]; ok {
+				continue
+			}
+			k := e.key
+			if loose {
+				k = e.loose
+			}
+			if idx := byKey[k]; len(idx) > 0 {
+				match[j], taken[idx[0]] = idx[0], true
+				byKey[k] = idx[1:]
+			}
+		}
+	}
+	return match
+}
+
+func linesOf(kind string, lines []string) []DiffLine {
+	out := make([]DiffLine, len(lines))
+	for i, l := range lines {
+		out[i] = DiffLine{Kind: kind}
+		if kind == LineDelete {
+			out[i].Old = l
+		} else {
+			out[i].New = l
+		}
+	}
+	return out
+}
+
+// diffSection diffs two sections line by line, then pairs removed and
+// added lines within each changed run and diffs those word by word.
+func diffSection(a, b []string) SectionDiff {
+	var out []DiffLine
+	var dels, ins []string
+	changed, total := 0, 0
+	for _, l := range a {
+		total += len(strings.Fields(l))
+	}
+	for _, l := range b {
+		total += len(strings.Fields(l))
+	}
+
+	flush := func() {
+		n := min(len(dels), len(ins))
+		for i := 0; i < n; i++ {
+			words := diffWords(dels[i], ins[i])
+			removed, added, same := 0, 0, 0
+			for _, w := range words {
+				c := len(strings.Fields(w.Text))
+				switch w.Kind {
+				case LineDelete:
+					removed += c
+				case LineInsert:
+					added += c
+				default:
+					same += c
+				}
+			}
+			// Lines with little in common read better as a plain remove/add.
+			if same*2 < max(removed, added) {
+				out = append(out, DiffLine{Kind: LineDelete, Old: dels[i]}, DiffLine{Kind: LineInsert, New: ins[i]})
+			} else {
+				out = append(out, DiffLine{Kind: LineChange, Old: dels[i], New: ins[i], Words: words})
+			}
+			changed += removed + added
+		}
+		for _, l := range dels[n:] {
+			out = append(out, DiffLine{Kind: LineDelete, Old: l})
+			changed += len(strings.Fields(l))
+		}
+		for _, l := range ins[n:] {
+			out = append(out, DiffLine{Kind: LineInsert, New: l})
+			changed += len(strings.Fields(l))
+		}
+		dels, ins = nil, nil
+	}
+	for _, e := range diffSeq(a, b) {
+		switch e.op {
+		case opDelete:
+			dels = append(dels, a[e.a])
+		case opInsert:
+			ins = append(ins, b[e.b])
+		default:
+			flush()
+			out = append(out, DiffLine{Kind: LineEqual, Old: a[e.a], New: b[e.b]})
+		}
+	}
+	flush()
+
+	sd := SectionDiff{Status: DiffUnchanged, Lines: out}
+	if changed > 0 {
+		sd.Status = DiffChanged
+		sd.Change = float64(changed) / float64(max(total, 1))
+	} else if len(a) != len(b) {
+		// Only blank-word lines differ.
+		sd.Status = DiffChanged
+	}
+	return sd
+}
+
+// diffWords diffs two lines word by word, merging runs of the same kind.
+func diffWords(a, b string) []DiffOp {
+	wa, wb := strings.Fields(a), strings.Fields(b)
+	var ops []DiffOp
+	for _, e := range diffSeq(wa, wb) {
+		kind, word := LineEqual, ""
+		switch e.op {
+		case opDelete:
+			kind, word = LineDelete, wa[e.a]
+		case opInsert:
+			kind, word = LineInsert, wb[e.b]
+		default:
+			word = wb[e.b]
+		}
+		if n := len(ops); n > 0 && ops[n-1].Kind == kind {
+			ops[n-1].Text += " " + word
+			continue
+		}
+		ops = append(ops, DiffOp{Kind: kind, Text: word})
+	}
+	return ops
+}
+
+// Changed returns the sections that differ, material or not.
+func (dd *DocumentDiff) Changed() []SectionDiff {
+	var out []SectionDiff
+	for _, s := range dd.Sections {
+		if s.Status != DiffUnchanged {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Tables implements Tabular with the section summary.
+func (dd *DocumentDiff) Tables() []Table {
+	t := Table{Name: "섹션 비교", Header: []string{"상태", "섹션", "이전 섹션", "변경률", "주요 변경"}}
+	for _, s := range dd.Sections {
+		material := ""
+		if s.Material {
+			material = "Y"
+		}
+		t.Rows = append(t.Rows, []any{diffStatusLabel(s.Status), s.Path, s.OldPath, s.Change, material})
+	}
+	return []Table{t}
+}
+
+func diffStatusLabel(status string) string {
+	switch status {
+	case DiffAdded:
+		return "추가"
+	case DiffRemoved:
+		return "삭제"
+	case DiffChanged:
+		return "변경"
+	default:
+		return "동일"
+	}
+}
+
+// DiffMarkdown renders the section summary followed by each materially
+// changed section (every changed one with all): a unified diff with
+// [-removed-]{+added+} word markers, or with sideBySide a two-column
+// table of the changed lines.
+func DiffMarkdown(dd *DocumentDiff, sideBySide, all bool) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## 공시 비교: %s → %s\n\n", dd.Old, dd.New)
+	writeDiff(&sb, dd, 3, sideBySide, all)
+	return sb.String()
+}
+
+// writeDiff writes the summary and section diffs of dd, with section
+// headings at the given level.
+func writeDiff(sb *strings.Builder, dd *DocumentDiff, level int, sideBySide, all bool) {
+	counts := map[string]int{}
+	minor := 0
+	for _, s := range dd.Sections {
+		counts[s.Status]++
+		if s.Status == DiffChanged && !s.Material {
+			minor++
+		}
+	}
+	fmt.Fprintf(sb, "추가 %d · 삭제 %d · 주요 변경 %d · 경미한 변경 %d · 동일 %d (주요 변경 기준: 단어 %.0f%% 이상)\n\n",
+		counts[DiffAdded], counts[DiffRemoved], counts[DiffChanged]-minor, minor, counts[DiffUnchanged], dd.Threshold*100)
+
+	changed := dd.Changed()
+	if len(changed) == 0 {
+		sb.WriteString("변경된 섹션이 없습니다.\n\n")
+		return
+	}
+	sb.WriteString("| 상태 | 섹션 | 변경률 |\n|---|---|---:|\n")
+	for _, s := range changed {
+		status := diffStatusLabel(s.Status)
+		if s.Status == DiffChanged && s.Material {
+			status = "**주요 변경**"
+		}
+		path := escapePipes(s.Path)
+		if s.OldPath != "" {
+			path += " (이전: " + escapePipes(s.OldPath) + ")"
+		}
+		fmt.Fprintf(sb, "| %s | %s | %.1f%% |\n", status, path, s.Change*100)
+	}
+	sb.WriteString("\n")
+
+	for _, s := range changed {
+		if !s.Material && !all {
+			continue
+		}
+		fmt.Fprintf(sb, "%s %s %s\n\n", hashes(level), diffStatusLabel(s.Status), s.Path)
+		if sideBySide {
+			writeSideBySide(sb, dd, s.Lines)
+		} else {
+			writeUnified(sb, s.Lines)
+		}
+	}
+}
+
+// writeUnified writes the changed lines with diffContext unchanged lines
+// around them in a diff code block.
+func writeUnified(sb *strings.Builder, lines []DiffLine) {
+	show := make([]bool, len(lines))
+	for i, l := range lines {
+		if l.Kind == LineEqual {
+			continue
+		}
+		for j := max(0, i-diffContext); j <= min(len(lines)-1, i+diffContext); j++ {
+			show[j] = true
+		}
+	}
+	sb.WriteString("```diff\n")
+	gap := false
+	for i, l := range lines {
+		if !show[i] {
+			gap = true
+			continue
+		}
+		if gap && i > 0 {
+			sb.WriteString("@@ … @@\n")
+		}
+		gap = false
+		switch l.Kind {
+		case LineEqual:
+			sb.WriteString("  " + l.New + "\n")
+		case LineDelete:
+			sb.WriteString("- " + l.Old + "\n")
+		case LineInsert:
+			sb.WriteString("+ " + l.New + "\n")
+		case LineChange:
+			sb.WriteString("- " + markWords(l.Words, LineDelete, "[-", "-]") + "\n")
+			sb.WriteString("+ " + markWords(l.Words, LineInsert, "{+", "+}") + "\n")
+		}
+	}
+	sb.WriteString("```\n\n")
+}
+
+// writeSideBySide writes the changed lines as an old/new table with
+// removed words struck through and added words in bold.
+func writeSideBySide(sb *strings.Builder, dd *DocumentDiff, lines []DiffLine) {
+	fmt.Fprintf(sb, "| %s | %s |\n|---|---|\n", escapePipes(dd.Old), escapePipes(dd.New))
+	for _, l := range lines {
+		var left, right string
+		switch l.Kind {
+		case LineDelete:
+			left = "~~" + escapePipes(l.Old) + "~~"
+		case LineInsert:
+			right = "**" + escapePipes(l.New) + "**"
+		case LineChange:
+			left = escapePipes(markWords(l.Words, LineDelete, "~~", "~~"))
+			right = escapePipes(markWords(l.Words, LineInsert, "**", "**"))
+		default:
+			continue
+		}
+		fmt.Fprintf(sb, "| %s | %s |\n", left, right)
+	}
+	sb.WriteString("\n")
+}
+
+// markWords rebuilds one side of a change line, wrapping the words only
+// on that side (kind) in open/close.
+func markWords(ops []DiffOp, kind, open, close string) string {
+	var parts []string
+	for _, op := range ops {
+		switch op.Kind {
+		case LineEqual:
+			parts = append(parts, op.Text)
+		case kind:
+			parts = append(parts, open+op.Text+close)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+type editOp int8
+
+const (
+	opEqual editOp = iota
+	opDelete
+	opInsert
+)
+
+// edit is one step of a diff script: a[a] and b[b] are equal, a[a] is
+// deleted, or b[b] is inserted.
+type edit struct {
+	op   editOp
+	a, b int
+}
+
+// maxEditDistance bounds the work of diffSeq. Sequences further apart
+// than this are treated as entirely replaced.
+const maxEditDistance = 4000
+
+// diffSeq returns a shortest edit script from a to b (Myers' algorithm)
+// after trimming the common prefix and suffix.
+func diffSeq(a, b []string) []edit {
+	pre := 0
+	for pre < len(a) && pre < len(b) && a[pre] == b[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(a)-pre && suf < len(b)-pre && a[len(a)-1-suf] == b[len(b)-1-suf] {
+		suf++
+	}
+
+	var out []edit
+	for i := 0; i < pre; i++ {
+		out = append(out, edit{opEqual, i, i})
+	}
+	out = append(out, myers(a[pre:len(a)-suf], b[pre:len(b)-suf], pre, pre)...)
+	for i := suf; i > 0; i-- {
+		out = append(out, edit{opEqual, len(a) - i, len(b) - i})
+	}
+	return out
+}
+
+// vWindow is the part of Myers' V array a backtrack step can read.
+type vWindow struct {
+	lo   int
+	vals []int
+}
+
+func (w vWindow) get(k int) int {
+	if i := k - w.lo; i >= 0 && i < len(w.vals) {
+		return w.vals[i]
+	}
+	return 0
+}
+
+func myers(a, b []string, offA, offB int) []edit {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return replaceAll(n, m, offA, offB)
+	}
+	limit := min(n+m, maxEditDistance)
+	off := limit + 1
+	v := make([]int, 2*off+1)
+	var trace []vWindow
+	for d := 0; d <= limit; d++ {
+		lo := max(-d-1, -off)
+		trace = append(trace, vWindow{lo, append([]int(nil), v[off+lo:off+min(d+1, off)+1]...)})
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[off+k-1] < v[off+k+1]) {
+				x = v[off+k+1]
+			} else {
+				x = v[off+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[off+k] = x
+			if x >= n && y >= m {
+				return backtrack(trace, n, m, offA, offB)
+			}
+		}
+	}
+	return replaceAll(n, m, offA, offB)
+}
+
+func backtrack(trace []vWindow, x, y, offA, offB int) []edit {
+	var rev []edit
+	for d := len(trace) - 1; d >= 0; d-- {
+		v := trace[d]
+		k := x - y
+		prevK := k - 1
+		if k == -d || (k != d && v.get(k-1) < v.get(k+1)) {
+			prevK = k + 1
+		}
+		prevX := v.get(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY && x > 0 && y > 0 {
+			rev = append(rev, edit{opEqual, offA + x - 1, offB + y - 1})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				rev = append(rev, edit{opInsert, offA + x, offB + y - 1})
+			} else {
+				rev = append(rev, edit{opDelete, offA + x - 1, offB + y})
+			}
+		}
+		x, y = prevX, prevY
+	}
+	out := make([]edit, len(rev))
+	for i, e := range rev {
+		out[len(rev)-1-i] = e
+	}
+	return out
+}
+
+func replaceAll(n, m, offA, offB int) []edit {
+	var out []edit
+	for i := 0; i < n; i++ {
+		out = append(out, edit{opDelete, offA + i, offB})
+	}
+	for j := 0; j < m; j++ {
+		out = append(out, edit{opInsert, offA + n, offB + j})
+	}
+	return out
+}
@@ -0,0 +1,74 @@
+package render
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffSeq(t *testing.T) {
+	a := strings.Fields("a b c e f")
+	b := strings.Fields("a c d e f g")
+	var got []string
+	for _, e := range diffSeq(a, b) {
+		switch e.op {
+		case opDelete:
+			got = append(got, "-"+a[e.a])
+		case opInsert:
+			got = append(got, "+"+b[e.b])
+		default:
+			got = append(got, a[e.a])
+		}
+	}
+	if s := strings.Join(got, " "); s != "a -b c +d e f +g" {
+		t.Errorf("편집 스크립트 = %s", s)
+	}
+}
+
+func TestDiffDocuments(t *testing.T) {
+	const oldXML = `<DOCUMENT><DOCUMENT-NAME>사업보고서</DOCUMENT-NAME><BODY>
+<SECTION-1><TITLE ATOC="Y">I. 회사의 개요</TITLE><P>당사는 1969년 설립되었습니다.</P></SECTION-1>
+<SECTION-1><TITLE ATOC="Y">II. 사업의 내용</TITLE>
+<SECTION-2><TITLE ATOC="Y">2. 주요 제품</TITLE>
+<TABLE><THEAD><TR><TH>부문</TH><TH>매출액</TH></TR></THEAD>
+<TBODY><TR><TD>DX</TD><TE>1,000</TE></TR><TR><TD>DS</TD><TE>500</TE></TR></TBODY></TABLE>
+</SECTION-2>
+<SECTION-2><TITLE ATOC="Y">3. 원재료</TITLE><P>원재료 가격은 안정적입니다.</P></SECTION-2>
+</SECTION-1></BODY></DOCUMENT>`
+	const newXML = `<DOCUMENT><DOCUMENT-NAME>사업보고서</DOCUMENT-NAME><BODY>
+<SECTION-1><TITLE ATOC="Y">I. 회사의 개요</TITLE><P>당사는 1969년 설립되었습니다.</P></SECTION-1>
+<SECTION-1><TITLE ATOC="Y">II. 사업의 내용</TITLE>
+<SECTION-2><TITLE ATOC="Y">1. 주요 제품</TITLE>
+<TABLE><THEAD><TR><TH>부문</TH><TH>매출액</TH></TR></THEAD>
+<TBODY><TR><TD>DX</TD><TE>1,200</TE></TR><TR><TD>DS</TD><TE>500</TE></TR></TBODY></TABLE>
+</SECTION-2>
+<SECTION-2><TITLE ATOC="Y">2. 연구개발</TITLE><P>연구개발 비용이 증가했습니다.</P></SECTION-2>
+</SECTION-1></BODY></DOCUMENT>`
+
+	dd := DiffDocuments("A", "B",
+		[]*Document{ParseDocument([]byte(oldXML))}, []*Document{ParseDocument([]byte(newXML))}, DefaultDiffThreshold)
+
+	var got []string
+	for _, s := range dd.Sections {
+		got = append(got, s.Status+":"+s.Path)
+	}
+	want := "unchanged:I. 회사의 개요|unchanged:II. 사업의 내용|changed:II. 사업의 내용 > 1. 주요 제품|" +
+		"added:II. 사업의 내용 > 2. 연구개발|removed:II. 사업의 내용 > 3. 원재료"
+	if s := strings.Join(got, "|"); s != want {
+		t.Fatalf("섹션 정렬\n got: %s\nwant: %s", s, want)
+	}
+
+	products := dd.Sections[2]
+	if products.OldPath != "II. 사업의 내용 > 2. 주요 제품" || !products.Material {
+		t.Errorf("번호가 바뀐 섹션 = %+v", products)
+	}
+	md := DiffMarkdown(dd, false, false)
+	if !strings.Contains(md, "- DX | [-1,000-]") || !strings.Contains(md, "+ DX | {+1,200+}") {
+		t.Errorf("단어 단위 diff가 없습니다:\n%s", md)
+	}
+	if !strings.Contains(md, "추가 1 · 삭제 1 · 주요 변경 1") {
+		t.Errorf("요약이 다릅니다:\n%s", md)
+	}
+	if side := DiffMarkdown(dd, true, false); !strings.Contains(side, "| DX ｜ ~~1,000~~ | DX ｜ **1,200** |") {
+		t.Errorf("나란히 보기가 다릅니다:\n%s", side)
+	}
+}
@@ -0,0 +1,188 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// DistressTypes are the 주요사항보고서 report-name keywords screened by
+// the distress command, in display order.
+var DistressTypes = []string{
+	"부도발생",
+	"영업정지",
+	"회생절차개시신청",
+	"해산사유발생",
+	"채권은행등의관리절차개시",
+}
+
+// DistressType returns the DistressTypes entry matched by a report name,
+// or "" if the report is not a distress event. Spacing is ignored.
+func DistressType(reportNm string) string {
+	name := strings.ReplaceAll(reportNm, " ", "")
+	for _, t := range DistressTypes {
+		if strings.Contains(name, t) {
+			return t
+		}
+	}
+	return ""
+}
+
+// DistressEvent is one distress filing with its structured details.
+type DistressEvent struct {
+	Type      string `json:"type"`
+	CorpName  string `json:"corp_name"`
+	CorpCode  string `json:"corp_code"`
+	StockCode string `json:"stock_code"`
+	CorpCls   string `json:"corp_cls"`
+	RceptNo   string `json:"rcept_no"`
+	RceptDt   string `json:"rcept_dt"`
+	Date      string `json:"date"` // event date: 부도일, 신청일, 결정일 …
+	Summary   string `json:"summary"`
+	Reason    string `json:"reason"`
+}
+
+func distressEvent(item api.DisclosureItem, typ string) DistressEvent {
+	return DistressEvent{
+		Type:      typ,
+		CorpName:  item.CorpName,
+		CorpCode:  item.CorpCode,
+		StockCode: item.StockCode,
+		CorpCls:   item.CorpCls,
+		RceptNo:   item.RceptNo,
+		RceptDt:   item.RceptDt,
+	}
+}
+
+// MatchReceipt returns the row filed under rceptNo, or the only row if the
+// endpoint returned exactly one.
+func MatchReceipt[T interface{ Receipt() string }](rows []T, rceptNo string) *T {
+	for i := range rows {
+		if rows[i].Receipt() == rceptNo {
+			return &rows[i]
+		}
+	}
+	if len(rows) == 1 {
+		return &rows[0]
+	}
+	return nil
+}
+
+// DefaultEvent builds a 부도발생 event; r may be nil when details are missing.
+func DefaultEvent(item api.DisclosureItem, r *api.DefaultOccurrence) DistressEvent {
+	e := distressEvent(item, "부도발생")
+	if r != nil {
+		e.Date = r.Date
+		e.Summary = joinNonEmpty(collapse(r.Content), wonAmount(r.Amount), collapse(r.Bank))
+		e.Reason = collapse(r.Reason)
+	}
+	return e
+}
+
+// SuspensionEvent builds a 영업정지 event; r may be nil when details are missing.
+func SuspensionEvent(item api.DisclosureItem, r *api.BusinessSuspension) DistressEvent {
+	e := distressEvent(item, "영업정지")
+	if r != nil {
+		e.Date = r.Date
+		ratio := ""
+		if s := strings.TrimSpace(r.SalesRatio); s != "" && s != "-" {
+			ratio = "매출액 대비 " + pct(s)
+		}
+		e.Summary = joinNonEmpty(collapse(r.Area), wonAmount(r.Amount), ratio)
+		e.Reason = collapse(firstNonEmpty(r.Reason, r.Content))
+	}
+	return e
+}
+
+// RehabilitationEvent builds a 회생절차 개시신청 event; r may be nil.
+func RehabilitationEvent(item api.DisclosureItem, r *api.RehabilitationFiling) DistressEvent {
+	e := distressEvent(item, "회생절차개시신청")
+	if r != nil {
+		e.Date = r.Date
+		e.Summary = joinNonEmpty(labelled("신청인", r.Applicant), labelled("관할법원", r.Court))
+		e.Reason = collapse(r.Reason)
+	}
+	return e
+}
+
+// DissolutionEvent builds a 해산사유 발생 event; r may be nil.
+func DissolutionEvent(item api.DisclosureItem, r *api.DissolutionCause) DistressEvent {
+	e := distressEvent(item, "해산사유발생")
+	if r != nil {
+		e.Date = r.Date
+		e.Reason = collapse(r.Reason)
+	}
+	return e
+}
+
+// CreditorManagementEvent builds a 채권은행 등의 관리절차 개시 event; r may be nil.
+func CreditorManagementEvent(item api.DisclosureItem, r *api.CreditorManagement) DistressEvent {
+	e := distressEvent(item, "채권은행등의관리절차개시")
+	if r != nil {
+		e.Date = r.Date
+		e.Summary = joinNonEmpty(labelled("관리기관", r.Institution), labelled("관리기간", r.Period))
+		e.Reason = collapse(r.Reason)
+	}
+	return e
+}
+
+// DistressMarkdown renders distress events grouped by type.
+func DistressMarkdown(startDate, endDate, market string, events []DistressEvent) string {
+	var sb strings.Builder
+
+	sb.WriteString("# 부실 징후 공시\n\n")
+	scope := "전체 상장사"
+	if market != "" {
+		scope = CorpClassLabel(market)
+	}
+	fmt.Fprintf(&sb, "%s ~ %s · %s · 총 **%d**건\n\n", FormatDate(startDate), FormatDate(endDate), scope, len(events))
+
+	sb.WriteString("| 유형 | 건수 |\n|------|---:|\n")
+	byType := map[string][]DistressEvent{}
+	for _, e := range events {
+		byType[e.Type] = append(byType[e.Type], e)
+	}
+	for _, t := range DistressTypes {
+		fmt.Fprintf(&sb, "| %s | %d |\n", t, len(byType[t]))
+	}
+	sb.WriteString("\n")
+
+	for _, t := range DistressTypes {
+		list := byType[t]
+		if len(list) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "## %s\n\n", t)
+		sb.WriteString("| 접수일 | 회사 | 시장 | 발생일 | 내용 | 사유 | 원문 |\n")
+		sb.WriteString("|--------|------|------|--------|------|------|------|\n")
+		for _, e := range list {
+			corp := e.CorpName
+			if e.StockCode != "" {
+				corp += " (" + e.StockCode + ")"
+			}
+			fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | [%s](%s) |\n",
+				FormatDate(e.RceptDt), corp, CorpClassLabel(e.CorpCls), dash(e.Date),
+				dash(e.Summary), dash(truncateRunes(e.Reason, 80)), e.RceptNo, DARTViewURL(e.RceptNo))
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func labelled(label, val string) string {
+	val = collapse(val)
+	if val == "" || val == "-" {
+		return ""
+	}
+	return label + " " + val
+}
+
+// truncateRunes shortens s to at most n runes, appending "…" when cut.
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n]) + "…"
+}
@@ -0,0 +1,77 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestDistressType(t *testing.T) {
+	cases := map[string]string{
+		"주요사항보고서(부도발생)":            "부도발생",
+		"[기재정정]주요사항보고서(회생절차 개시신청)": "회생절차개시신청",
+		"주요사항보고서(채권은행 등의 관리절차 개시)": "채권은행등의관리절차개시",
+		"주요사항보고서(영업정지)":            "영업정지",
+		"주요사항보고서(유상증자결정)":          "",
+		"주요사항보고서(해산사유 발생)":         "해산사유발생",
+	}
+	for in, want := range cases {
+		if got := DistressType(in); got != want {
+			t.Errorf("DistressType(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestMatchReceipt(t *testing.T) {
+	rows := []api.DefaultOccurrence{
+		{ReportHeader: api.ReportHeader{RceptNo: "A"}, Date: "2024-03-01"},
+		{ReportHeader: api.ReportHeader{RceptNo: "B"}, Date: "2024-03-05"},
+	}
+	if got := MatchReceipt(rows, "B"); got == nil || got.Date != "2024-03-05" {
+		t.Errorf("접수번호 일치 행 기대, got %+v", got)
+	}
+	if got := MatchReceipt(rows, "C"); got != nil {
+		t.Errorf("여러 행 중 일치 없으면 nil 기대, got %+v", got)
+	}
+	if got := MatchReceipt(rows[:1], "C"); got == nil || got.RceptNo != "A" {
+		t.Errorf("행이 하나뿐이면 그 행 기대, got %+v", got)
+	}
+}
+
+func TestDistressMarkdown(t *testing.T) {
+	item := api.DisclosureItem{
+		CorpName: "가나다", StockCode: "123456", CorpCls: "K",
+		RceptNo: "20240305000123", RceptDt: "20240305",
+	}
+	rows := []api.RehabilitationFiling{
+		{ReportHeader: api.ReportHeader{RceptNo: "20240301000999"}, Applicant: "다른회사"},
+		{ReportHeader: api.ReportHeader{RceptNo: "20240305000123"},
+			Applicant: "가나다", Court: "서울회생법원", Reason: "유동성\n악화", Date: "2024-03-04"},
+	}
+	events := []DistressEvent{
+		RehabilitationEvent(item, MatchReceipt(rows, item.RceptNo)),
+		// Details missing: the event keeps the filing metadata only.
+		DefaultEvent(api.DisclosureItem{CorpName: "라마바", RceptNo: "20240306000001", RceptDt: "20240306"}, nil),
+	}
+	if e := events[0]; e.Summary != "신청인 가나다 / 관할법원 서울회생법원" || e.Reason != "유동성 악화" || e.Date != "2024-03-04" {
+		t.Errorf("접수번호 기준 상세 병합 기대, got %+v", e)
+	}
+
+	md := DistressMarkdown("20240301", "20240307", "", events)
+	for _, want := range []string{
+		"| 회생절차개시신청 | 1 |",
+		"| 부도발생 | 1 |",
+		"| 영업정지 | 0 |",
+		"## 회생절차개시신청",
+		"| 2024-03-05 | 가나다 (123456) | 코스닥 | 2024-03-04 | 신청인 가나다 / 관할법원 서울회생법원 | 유동성 악화 |",
+		"| 2024-03-06 | 라마바 |  | - | - | - |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("출력에 %q 없음:\n%s", want, md)
+		}
+	}
+	if strings.Contains(md, "## 영업정지") {
+		t.Error("건수 0인 유형은 섹션 생략 기대")
+	}
+}
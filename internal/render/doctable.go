@@ -0,0 +1,195 @@
+package render
+
+import (
+	"strings"
+)
+
+// GridCell is one position of a table expanded onto a grid. Origin is
+// false for positions covered by a COLSPAN/ROWSPAN of another cell.
+type GridCell struct {
+	Text   string
+	Value  *float64
+	Header bool
+	Origin bool
+
+	src [2]int // row and index within the row of the source cell
+}
+
+// TableLayout is a table resolved for display: spans expanded, the header
+// rows combined into one label per column and blank rows dropped.
+type TableLayout struct {
+	Header  []string     `json:"header"`
+	Rows    [][]string   `json:"rows"`
+	Values  [][]*float64 `json:"values"`
+	Numeric []bool       `json:"numeric"`
+}
+
+// Grid expands spanned cells so every row has one cell per column.
+// Spanned positions carry the spanning cell's text.
+func (t *DocTable) Grid() [][]GridCell {
+	var grid [][]GridCell
+	taken := map[[2]int]bool{}
+	width := 0
+
+	for r, row := range t.Rows {
+		col := 0
+		for i, c := range row {
+			for taken[[2]int{r, col}] {
+				col++
+			}
+			cs, rs := max(c.ColSpan, 1), max(c.RowSpan, 1)
+			// A ROWSPAN running past the last row is clamped.
+			rs = min(rs, len(t.Rows)-r)
+			for dr := 0; dr < rs; dr++ {
+				for dc := 0; dc < cs; dc++ {
+					taken[[2]int{r + dr, col + dc}] = true
+					for len(grid) <= r+dr {
+						grid = append(grid, nil)
+					}
+					line := grid[r+dr]
+					for len(line) <= col+dc {
+						line = append(line, GridCell{})
+					}
+					line[col+dc] = GridCell{Text: c.Text, Value: c.Value, Header: c.Header, Origin: dr == 0 && dc == 0, src: [2]int{r, i}}
+					grid[r+dr] = line
+				}
+			}
+			col += cs
+			width = max(width, col)
+		}
+	}
+
+	for r := range grid {
+		for len(grid[r]) < width {
+			grid[r] = append(grid[r], GridCell{})
+		}
+	}
+	return grid
+}
+
+// headerRows returns how many leading grid rows form the header: the run
+// of rows made only of header cells (at least the first row), extended
+// over any ROWSPAN that starts inside it.
+func (t *DocTable) headerRows() int {
+	n := 0
+	for _, row := range t.Rows {
+		all := len(row) > 0
+		for _, c := range row {
+			if !c.Header {
+				all = false
+				break
+			}
+		}
+		if !all {
+			break
+		}
+		n++
+	}
+	n = max(n, 1)
+	for r := 0; r < n && r < len(t.Rows); r++ {
+		for _, c := range t.Rows[r] {
+			n = max(n, r+max(c.RowSpan, 1))
+		}
+	}
+	return min(n, len(t.Rows))
+}
+
+// Layout resolves the table for display. Header labels join the distinct
+// texts stacked in each header column ("당기 / 금액"), and a column that
+// only continues a COLSPAN from its left is left unlabelled. In the body
+// only a spanning cell's first position keeps its text. Columns whose body
+// cells are all numbers (or "-") are marked Numeric.
+func (t *DocTable) Layout() *TableLayout {
+	grid := t.Grid()
+	h := min(t.headerRows(), len(grid))
+	l := &TableLayout{}
+	if len(grid) == 0 {
+		return l
+	}
+	width := len(grid[0])
+
+	for c := 0; c < width; c++ {
+		if c > 0 && continuesLeft(grid[:h], c) {
+			l.Header = append(l.Header, "")
+			continue
+		}
+		var parts []string
+		for r := 0; r < h; r++ {
+			text := grid[r][c].Text
+			if text != "" && (len(parts) == 0 || parts[len(parts)-1] != text) {
+				parts = append(parts, text)
+			}
+		}
+		l.Header = append(l.Header, strings.Join(parts, " / "))
+	}
+
+	for _, gr := range grid[h:] {
+		row := make([]string, width)
+		values := make([]*float64, width)
+		blank := true
+		for c, cell := range gr {
+			if cell.Origin {
+				row[c], values[c] = cell.Text, cell.Value
+				blank = blank && cell.Text == ""
+			}
+		}
+		if !blank {
+			l.Rows = append(l.Rows, row)
+			l.Values = append(l.Values, values)
+		}
+	}
+
+	l.Numeric = make([]bool, width)
+	for c := 0; c < width; c++ {
+		numbers, others := 0, 0
+		for _, row := range l.Rows {
+			switch v := row[c]; {
+			case v == "" || isDash(v):
+			case isNumber(v):
+				numbers++
+			default:
+				others++
+			}
+		}
+		l.Numeric[c] = numbers > 0 && others == 0
+	}
+	return l
+}
+
+// continuesLeft reports whether every row of column c is covered by the
+// same cell as column c-1.
+func continuesLeft(rows [][]GridCell, c int) bool {
+	for _, row := range rows {
+		if row[c].src != row[c-1].src || row[c].Origin {
+			return false
+		}
+	}
+	return true
+}
+
+func isDash(s string) bool {
+	switch strings.TrimSpace(s) {
+	case "-", "–", "—", "－":
+		return true
+	}
+	return false
+}
+
+// isNumber reports whether s is a figure as written in DART tables.
+func isNumber(s string) bool {
+	_, ok := ParseNumber(s)
+	return ok
+}
+
+// Documents is view output: the parsed files of one filing.
+type Documents []*Document
+
+// Tables implements Tabular with one table per document table, named
+// after its section and unit. Numeric cells are exported as numbers.
+func (ds Documents) Tables() []Table {
+	var tables []Table
+	for _, t := range ExtractTables(ds) {
+		tables = append(tables, t.Export())
+	}
+	return tables
+}
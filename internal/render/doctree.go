@@ -0,0 +1,207 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Block kinds within a Document.
+const (
+	BlockHeading   = "heading"
+	BlockParagraph = "paragraph"
+	BlockCaption   = "caption"
+	BlockTable     = "table"
+)
+
+// Document is the parsed tree of a single DART XML file. Markdown, JSON
+// and the other view outputs are all produced from it. CorpCode is the
+// DART 고유번호 from COMPANY-NAME's AREGCIK attribute.
+type Document struct {
+	Name     string     `json:"name,omitempty"`
+	Title    string     `json:"title,omitempty"`
+	Company  string     `json:"company,omitempty"`
+	CorpCode string     `json:"corp_code,omitempty"`
+	Cover    []Block    `json:"cover,omitempty"`
+	TOC      []TOCEntry `json:"toc,omitempty"`
+	Blocks   []Block    `json:"blocks,omitempty"`
+	Sections []*Section `json:"sections,omitempty"`
+	// Warnings lists the malformed fragments skipped while parsing.
+	Warnings []ParseWarning `json:"warnings,omitempty"`
+}
+
+// Section is a SECTION-N element. Path joins the titles of the section
+// and its ancestors, e.g. "II. 사업의 내용 > 2. 주요 제품".
+type Section struct {
+	Number   string     `json:"number"`
+	Title    string     `json:"title"`
+	Path     string     `json:"path"`
+	Level    int        `json:"level"`
+	Blocks   []Block    `json:"blocks,omitempty"`
+	Sections []*Section `json:"sections,omitempty"`
+}
+
+// TOCEntry is one line of the table of contents. Number is the section's
+// position in the tree ("2.3" is the third child of the second chapter).
+type TOCEntry struct {
+	Number string `json:"number"`
+	Title  string `json:"title"`
+	Path   string `json:"path"`
+	Level  int    `json:"level"`
+}
+
+// Block is a piece of content in document order.
+type Block struct {
+	Kind  string    `json:"kind"`
+	Level int       `json:"level,omitempty"`
+	Text  string    `json:"text,omitempty"`
+	Table *DocTable `json:"table,omitempty"`
+}
+
+// DocTable is a table as written in the source: rows of cells, with
+// spans recorded but not expanded. Layout resolves it for display.
+type DocTable struct {
+	Unit *Unit       `json:"unit,omitempty"`
+	Rows [][]DocCell `json:"rows"`
+}
+
+// DocCell is a TD/TH/TU/TE cell. Spans of 1 are left as zero. Value is
+// the parsed figure (see ParseNumber) for numeric cells.
+type DocCell struct {
+	Text    string   `json:"text"`
+	Value   *float64 `json:"value,omitempty"`
+	Header  bool     `json:"header,omitempty"`
+	ColSpan int      `json:"colspan,omitempty"`
+	RowSpan int      `json:"rowspan,omitempty"`
+}
+
+// Empty reports whether the document has no content at all.
+func (d *Document) Empty() bool {
+	return d.Title == "" && d.Company == "" && len(d.Cover) == 0 &&
+		len(d.Blocks) == 0 && len(d.Sections) == 0
+}
+
+// finish fills in section paths and the table of contents.
+func (d *Document) finish() {
+	d.TOC = nil
+	var walk func(secs []*Section, parent, number string)
+	walk = func(secs []*Section, parent, number string) {
+		for i, s := range secs {
+			s.Number = fmt.Sprintf("%d", i+1)
+			if number != "" {
+				s.Number = number + "." + s.Number
+			}
+			s.Path = parent
+			if s.Title != "" {
+				if parent != "" {
+					s.Path = parent + " > " + s.Title
+				} else {
+					s.Path = s.Title
+				}
+				d.TOC = append(d.TOC, TOCEntry{Number: s.Number, Title: s.Title, Path: s.Path, Level: s.Level})
+			}
+			walk(s.Sections, s.Path, s.Number)
+		}
+	}
+	walk(d.Sections, "", "")
+}
+
+// Markdown serializes the document as markdown.
+func (d *Document) Markdown() string {
+	var sb strings.Builder
+	if d.Title != "" {
+		fmt.Fprintf(&sb, "# %s\n\n", d.Title)
+	}
+	if d.Company != "" {
+		fmt.Fprintf(&sb, "> %s\n\n", d.Company)
+	}
+	writeBlocks(&sb, d.Cover)
+	writeBlocks(&sb, d.Blocks)
+	for _, s := range d.Sections {
+		writeSection(&sb, s)
+	}
+	return sb.String()
+}
+
+// Heading returns the section title as a heading block: SECTION-1 → ##,
+// SECTION-2 → ###, and so on.
+func (s *Section) Heading() Block {
+	level := s.Level + 1
+	if s.Level <= 0 {
+		level = 2
+	}
+	return Block{Kind: BlockHeading, Level: level, Text: s.Title}
+}
+
+// Markdown serializes the section and its subsections.
+func (s *Section) Markdown() string {
+	var sb strings.Builder
+	writeSection(&sb, s)
+	return sb.String()
+}
+
+func writeSection(sb *strings.Builder, s *Section) {
+	if s.Title != "" {
+		sb.WriteString(s.Heading().Markdown())
+	}
+	writeBlocks(sb, s.Blocks)
+	for _, c := range s.Sections {
+		writeSection(sb, c)
+	}
+}
+
+func writeBlocks(sb *strings.Builder, blocks []Block) {
+	for _, b := range blocks {
+		sb.WriteString(b.Markdown())
+	}
+}
+
+// Markdown serializes a single block, followed by a blank line.
+func (b Block) Markdown() string {
+	switch b.Kind {
+	case BlockHeading:
+		return fmt.Sprintf("%s %s\n\n", hashes(b.Level), b.Text)
+	case BlockCaption:
+		return fmt.Sprintf("*%s*\n\n", b.Text)
+	case BlockTable:
+		var sb strings.Builder
+		if b.Table != nil {
+			writeMarkdownTable(&sb, b.Table)
+		}
+		return sb.String()
+	default:
+		return fmt.Sprintf("%s\n\n", b.Text)
+	}
+}
+
+// writeMarkdownTable writes t as a pipe table with spans expanded, the
+// header rows combined into one and numeric columns right-aligned. Cells
+// keep their text in the tree; "|" is only escaped here.
+func writeMarkdownTable(sb *strings.Builder, t *DocTable) {
+	l := t.Layout()
+	if len(l.Header) == 0 {
+		return
+	}
+
+	writeRow := func(cells []string) {
+		sb.WriteString("|")
+		for _, c := range cells {
+			fmt.Fprintf(sb, " %s |", escapePipes(c))
+		}
+		sb.WriteString("\n")
+	}
+
+	writeRow(l.Header)
+	sb.WriteString("|")
+	for _, num := range l.Numeric {
+		if num {
+			sb.WriteString("---:|")
+		} else {
+			sb.WriteString("---|")
+		}
+	}
+	sb.WriteString("\n")
+	for _, r := range l.Rows {
+		writeRow(r)
+	}
+	sb.WriteString("\n")
+}
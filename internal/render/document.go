@@ -7,76 +7,268 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 )
 
 // DocumentFromZIP extracts content from a DART document ZIP and
 // converts it to markdown for terminal rendering.
 func DocumentFromZIP(zipBytes []byte, rceptNo string) (string, error) {
-	zr, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+	docs, err := ParseDocumentZIP(zipBytes, rceptNo, FileSelection{})
 	if err != nil {
-		return "", fmt.Errorf("ZIP 파싱 실패: %w", err)
+		return "", err
 	}
+	return DocumentsMarkdown(docs), nil
+}
+
+// DefaultFileCount is how many files view renders when no file is chosen.
+const DefaultFileCount = 3
+
+// DocumentFile is one readable file in a DART document ZIP. Index is its
+// 1-based position in view's order: the main document (named after the
+// 접수번호) first, then attachments by size.
+type DocumentFile struct {
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	Size  uint64 `json:"size"`
+
+	f *zip.File
+}
 
-	type candidate struct {
-		name string
-		size uint64
-		f    *zip.File
+// FileSelection picks which files of a document ZIP to parse. The zero
+// value parses the first DefaultFileCount files.
+type FileSelection struct {
+	All  bool   // every file, in order
+	File string // a 1-based index, or a file name or title to match
+}
+
+// ListDocumentFiles returns every readable file in a DART document ZIP
+// with the title from its DOCUMENT-NAME.
+func ListDocumentFiles(zipBytes []byte, rceptNo string) ([]DocumentFile, error) {
+	files, err := documentFiles(zipBytes, rceptNo)
+	if err != nil {
+		return nil, err
 	}
-	var candidates []candidate
+	for i := range files {
+		if data, err := readZIPFile(files[i].f); err == nil {
+			files[i].Title = documentTitle(data)
+		}
+	}
+	return files, nil
+}
+
+// ParseDocumentZIP parses the files of a DART document ZIP chosen by sel
+// into trees, skipping files with no content.
+func ParseDocumentZIP(zipBytes []byte, rceptNo string, sel FileSelection) ([]*Document, error) {
+	files, err := documentFiles(zipBytes, rceptNo)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case sel.File != "":
+		f, err := pickFile(files, sel.File)
+		if err != nil {
+			return nil, err
+		}
+		files = []DocumentFile{f}
+	case !sel.All && len(files) > DefaultFileCount:
+		files = files[:DefaultFileCount]
+	}
+
+	var docs []*Document
+	for _, f := range files {
+		data, err := readZIPFile(f.f)
+		if err != nil {
+			continue
+		}
+
+		doc := ParseDocument(data)
+		if doc.Empty() {
+			continue
+		}
+		doc.Name = f.Name
+		docs = append(docs, doc)
+	}
+
+	if len(docs) == 0 {
+		return nil, fmt.Errorf("문서 내용을 추출할 수 없습니다")
+	}
+	return docs, nil
+}
+
+// documentFiles lists the XML/HTML files of a ZIP in view's order.
+func documentFiles(zipBytes []byte, rceptNo string) ([]DocumentFile, error) {
+	zr, err := zip.NewReader(bytes.NewReader(zipBytes), int64(len(zipBytes)))
+	if err != nil {
+		return nil, fmt.Errorf("ZIP 파싱 실패: %w", err)
+	}
+
+	var files []DocumentFile
 	for _, f := range zr.File {
 		if f.FileInfo().IsDir() {
 			continue
 		}
 		ext := strings.ToLower(filepath.Ext(f.Name))
 		if ext == ".xml" || ext == ".htm" || ext == ".html" {
-			candidates = append(candidates, candidate{f.Name, f.UncompressedSize64, f})
+			files = append(files, DocumentFile{Name: filepath.Base(f.Name), Size: f.UncompressedSize64, f: f})
 		}
 	}
-	if len(candidates) == 0 {
-		return "", fmt.Errorf("문서 내에 읽을 수 있는 파일이 없습니다")
+	if len(files) == 0 {
+		return nil, fmt.Errorf("문서 내에 읽을 수 있는 파일이 없습니다")
 	}
 
-	sort.Slice(candidates, func(i, j int) bool {
-		iMatch := strings.Contains(candidates[i].name, rceptNo)
-		jMatch := strings.Contains(candidates[j].name, rceptNo)
-		if iMatch != jMatch {
-			return iMatch
+	// Attachments are named <접수번호>_<code>.xml, so the main document is
+	// the one named exactly after the 접수번호.
+	rank := func(name string) int {
+		switch {
+		case strings.TrimSuffix(name, filepath.Ext(name)) == rceptNo:
+			return 0
+		case strings.Contains(name, rceptNo):
+			return 1
+		}
+		return 2
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		if ri, rj := rank(files[i].Name), rank(files[j].Name); ri != rj {
+			return ri < rj
 		}
-		return candidates[i].size > candidates[j].size
+		return files[i].Size > files[j].Size
 	})
+	for i := range files {
+		files[i].Index = i + 1
+	}
+	return files, nil
+}
 
-	var sb strings.Builder
-	for i, c := range candidates {
-		if i >= 3 {
-			break
+// pickFile resolves --file: an index, an exact file name or title, or a
+// fragment of exactly one file's name or title.
+func pickFile(files []DocumentFile, spec string) (DocumentFile, error) {
+	spec = strings.TrimSpace(spec)
+	if n, err := strconv.Atoi(spec); err == nil {
+		if n < 1 || n > len(files) {
+			return DocumentFile{}, fmt.Errorf("파일 번호는 1~%d 사이여야 합니다: %d", len(files), n)
 		}
-		rc, err := c.f.Open()
-		if err != nil {
-			continue
+		return files[n-1], nil
+	}
+
+	for i, f := range files {
+		if strings.EqualFold(f.Name, spec) {
+			return f, nil
+		}
+		if f.Title == "" {
+			if data, err := readZIPFile(f.f); err == nil {
+				files[i].Title = documentTitle(data)
+			}
+		}
+	}
+	for _, f := range files {
+		if f.Title == spec {
+			return f, nil
+		}
+	}
+	var matches []DocumentFile
+	needle := strings.ToLower(spec)
+	for _, f := range files {
+		if strings.Contains(strings.ToLower(f.Name), needle) || strings.Contains(strings.ToLower(f.Title), needle) {
+			matches = append(matches, f)
 		}
-		data, err := io.ReadAll(rc)
-		rc.Close()
+	}
+	switch len(matches) {
+	case 0:
+		return DocumentFile{}, fmt.Errorf("파일을 찾을 수 없습니다: %s (--list-files로 목록을 확인하세요)", spec)
+	case 1:
+		return matches[0], nil
+	}
+	var names []string
+	for _, f := range matches {
+		names = append(names, fmt.Sprintf("%d. %s", f.Index, f.Name))
+	}
+	return DocumentFile{}, fmt.Errorf("여러 파일이 일치합니다: %s (번호로 지정하세요)", strings.Join(names, ", "))
+}
+
+func readZIPFile(f *zip.File) ([]byte, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+// documentTitle returns the DOCUMENT-NAME of a DART XML file (or the
+// <title> of an HTML one) without parsing the body.
+func documentTitle(data []byte) string {
+	dec := xml.NewDecoder(bytes.NewReader(sanitizeXML(data)))
+	dec.Strict = false
+	dec.AutoClose = xml.HTMLAutoClose
+	dec.Entity = xml.HTMLEntity
+	p := &dartParser{dec: dec}
+	for {
+		tok, err := dec.Token()
 		if err != nil {
-			continue
+			return ""
+		}
+		if t, ok := tok.(xml.StartElement); ok {
+			switch upcase(t.Name.Local) {
+			case "DOCUMENT-NAME", "TITLE":
+				return collapse(p.collectText())
+			case "BODY":
+				return ""
+			}
 		}
+	}
+}
 
-		md := strings.TrimSpace(dartXMLToMarkdown(data))
-		if md == "" {
-			continue
+// DocumentFilesMarkdown lists the files of a ZIP, marking the ones view
+// renders by default.
+func DocumentFilesMarkdown(rceptNo string, files []DocumentFile) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## 첨부 파일 목록 (%s)\n\n", rceptNo)
+	sb.WriteString("| # | 파일 | 문서 | 크기 | 기본 출력 |\n")
+	sb.WriteString("|---:|---|---|---:|:---:|\n")
+	for _, f := range files {
+		mark := ""
+		if f.Index <= DefaultFileCount {
+			mark = "✓"
 		}
-		if len(candidates) > 1 {
-			fmt.Fprintf(&sb, "\n---\n*%s*\n\n", filepath.Base(c.name))
+		title := f.Title
+		if title == "" {
+			title = "-"
 		}
-		sb.WriteString(md)
-		sb.WriteString("\n")
+		fmt.Fprintf(&sb, "| %d | %s | %s | %s | %s |\n", f.Index, f.Name, escapePipes(title), formatSize(f.Size), mark)
 	}
+	sb.WriteString("\n`--file <번호|이름>`으로 특정 파일을, `--all-files`로 전체 파일을 볼 수 있습니다.\n")
+	return sb.String()
+}
 
-	if sb.Len() == 0 {
-		return "", fmt.Errorf("문서 내용을 추출할 수 없습니다")
+// formatSize formats a byte count as B, KB or MB.
+func formatSize(n uint64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1fMB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1fKB", float64(n)/(1<<10))
+	}
+	return fmt.Sprintf("%dB", n)
+}
+
+// DocumentsMarkdown renders parsed files in order, each preceded by its
+// file name when there is more than one.
+func DocumentsMarkdown(docs []*Document) string {
+	var sb strings.Builder
+	for _, d := range docs {
+		if len(docs) > 1 {
+			fmt.Fprintf(&sb, "\n---\n*%s*\n\n", d.Name)
+		}
+		sb.WriteString(strings.TrimSpace(d.Markdown()))
+		sb.WriteString("\n")
 	}
-	return sb.String(), nil
+	return sb.String()
 }
 
 // ── DART XML → Markdown ──────────────────────────────────────────────────────
@@ -88,51 +280,168 @@ func DocumentFromZIP(zipBytes []byte, rceptNo string) (string, error) {
 func sanitizeXML(data []byte) []byte {
 	out := make([]byte, 0, len(data))
 	for i := 0; i < len(data); i++ {
-		if data[i] != '<' {
-			out = append(out, data[i])
-			continue
-		}
-		// '<' — check next byte for valid XML name start or special marker
-		if i+1 < len(data) {
-			next := data[i+1]
-			// Valid XML tag starters: a-z A-Z _ : / ! ? >
-			if (next >= 'a' && next <= 'z') || (next >= 'A' && next <= 'Z') ||
-				next == '_' || next == ':' || next == '/' || next == '!' ||
-				next == '?' || next == '>' {
-				out = append(out, '<')
-			} else {
-				out = append(out, '&', 'l', 't', ';')
-			}
+		if bareLT(data, i) {
+			out = append(out, '&', 'l', 't', ';')
 		} else {
-			out = append(out, '<')
+			out = append(out, data[i])
 		}
 	}
 	return out
 }
 
+// bareLT reports whether data[i] is a '<' that does not start a tag.
+func bareLT(data []byte, i int) bool {
+	if data[i] != '<' || i+1 >= len(data) {
+		return false
+	}
+	// Valid XML tag starters: a-z A-Z _ : / ! ? >
+	next := data[i+1]
+	return !((next >= 'a' && next <= 'z') || (next >= 'A' && next <= 'Z') ||
+		next == '_' || next == ':' || next == '/' || next == '!' ||
+		next == '?' || next == '>')
+}
+
+// dartXMLToMarkdown parses data and serializes it straight to markdown.
 func dartXMLToMarkdown(data []byte) string {
-	data = sanitizeXML(data)
-	dec := xml.NewDecoder(bytes.NewReader(data))
+	return ParseDocument(data).Markdown()
+}
+
+// ParseDocument parses one DART XML file into a document tree. Malformed
+// fragments are skipped (see dartParser.token) and listed in Warnings.
+func ParseDocument(data []byte) *Document {
+	clean := sanitizeXML(data)
+	p := &dartParser{dec: newDARTDecoder(bytes.NewReader(clean)), doc: &Document{}, data: clean, resync: -1}
+	p.run()
+	p.doc.finish()
+	originalOffsets(data, p.doc.Warnings)
+	return p.doc
+}
+
+func newDARTDecoder(r io.Reader) *xml.Decoder {
+	dec := xml.NewDecoder(r)
 	dec.Strict = false
 	dec.Entity = xml.HTMLEntity
+	return dec
+}
 
-	p := &dartParser{dec: dec}
-	p.run()
-	return p.out.String()
+// ParseWarning is a syntax error the parser skipped over. Offset is the
+// byte offset in the original file where decoding failed.
+type ParseWarning struct {
+	Offset  int64  `json:"offset"`
+	Message string `json:"message"`
 }
 
-// dartParser walks DART's XML token stream and emits markdown.
-// It tracks an element-name stack so TITLE can know its heading depth.
+// knownElement matches the start or end tag of an element the parser
+// handles; after a syntax error parsing resumes at the next one.
+var knownElement = regexp.MustCompile(`(?i)</?(?:DOCUMENT|DOCUMENT-NAME|COMPANY-NAME|BODY|COVER|COVER-TITLE|SECTION-\d+|TITLE|P|TABLE-GROUP|TABLE|THEAD|TBODY|TR|TD|TH|TE|TU|SPAN|LIBRARY)[\s/>]`)
+
+// dartParser walks DART's XML token stream and builds a Document.
+// It tracks an element-name stack so TITLE can know its heading depth,
+// and the open SECTION-N elements so content lands in the right section.
 type dartParser struct {
 	dec   *xml.Decoder
-	out   strings.Builder
-	stack []string // element names currently open
+	doc   *Document
+	stack []string   // element names currently open
+	open  []*Section // SECTION-N elements currently open
+
+	// Error recovery; data is nil when recovery is off.
+	data   []byte   // sanitized input
+	base   int64    // offset of the current decoder's input within data
+	resync int64    // where the last recovery resumed
+	elems  []string // every element open in the token stream
+}
+
+// token returns the next token. On a syntax error it records a warning,
+// skips ahead to the next known element and carries on with a fresh
+// decoder, first replaying the elements still open so that their end tags
+// match. The error is returned only at the end of input.
+func (p *dartParser) token() (xml.Token, error) {
+	for {
+		start := p.base + p.dec.InputOffset()
+		tok, err := p.dec.Token()
+		if err == nil {
+			switch t := tok.(type) {
+			case xml.StartElement:
+				p.elems = append(p.elems, t.Name.Local)
+			case xml.EndElement:
+				if n := len(p.elems); n > 0 {
+					p.elems = p.elems[:n-1]
+				}
+			}
+			return tok, nil
+		}
+		if err == io.EOF || p.data == nil {
+			return nil, err
+		}
+
+		offset := p.base + p.dec.InputOffset()
+		p.doc.Warnings = append(p.doc.Warnings, ParseWarning{Offset: badByte(p.data, max(start, 0), offset), Message: err.Error()})
+		from := max(offset, p.resync+1)
+		if from >= int64(len(p.data)) {
+			return nil, io.EOF
+		}
+		loc := knownElement.FindIndex(p.data[from:])
+		if loc == nil {
+			return nil, io.EOF
+		}
+		pos := from + int64(loc[0])
+		p.resync = pos
+
+		var reopen strings.Builder
+		for _, name := range p.elems {
+			reopen.WriteString("<" + name + ">")
+		}
+		p.dec = newDARTDecoder(io.MultiReader(strings.NewReader(reopen.String()), bytes.NewReader(p.data[pos:])))
+		p.base = pos - int64(reopen.Len())
+		for range p.elems {
+			p.dec.Token()
+		}
+	}
+}
+
+// badByte returns the offset of the first invalid UTF-8 sequence or
+// character XML forbids in data[start:end], or start when there is none
+// (the error is in the markup of the token starting there).
+func badByte(data []byte, start, end int64) int64 {
+	end = min(end, int64(len(data)))
+	for i := start; i < end; {
+		r, size := utf8.DecodeRune(data[i:end])
+		if r == utf8.RuneError && size == 1 || !xmlChar(r) {
+			return i
+		}
+		i += int64(size)
+	}
+	return start
+}
+
+// xmlChar reports whether r may appear in an XML document.
+func xmlChar(r rune) bool {
+	return r == 0x09 || r == 0x0A || r == 0x0D ||
+		r >= 0x20 && r <= 0xD7FF || r >= 0xE000 && r <= 0xFFFD || r >= 0x10000 && r <= 0x10FFFF
+}
+
+// originalOffsets maps warning offsets in sanitizeXML's output back to data.
+// Warnings are recorded in decode order, so one forward pass covers them all.
+func originalOffsets(data []byte, warnings []ParseWarning) {
+	var i int
+	var out int64
+	for w := range warnings {
+		for i < len(data) && out < warnings[w].Offset {
+			if bareLT(data, i) {
+				out += int64(len("&lt;"))
+			} else {
+				out++
+			}
+			i++
+		}
+		warnings[w].Offset = int64(i)
+	}
 }
 
 // run is the main token loop.
 func (p *dartParser) run() {
 	for {
-		tok, err := p.dec.Token()
+		tok, err := p.token()
 		if err != nil {
 			break
 		}
@@ -140,11 +449,14 @@ func (p *dartParser) run() {
 		case xml.StartElement:
 			name := upcase(t.Name.Local)
 			p.stack = append(p.stack, name)
-			endConsumed := p.handleStart(name)
+			endConsumed := p.handleStart(name, t)
 			if endConsumed {
 				p.pop()
 			}
 		case xml.EndElement:
+			if strings.HasPrefix(upcase(t.Name.Local), "SECTION-") && len(p.open) > 0 {
+				p.open = p.open[:len(p.open)-1]
+			}
 			p.pop()
 		}
 		// CharData at the top level is ignored; text is gathered inside handlers.
@@ -157,9 +469,59 @@ func (p *dartParser) pop() {
 	}
 }
 
+// emit appends a block to the cover, the innermost open section, or the
+// document body, depending on where the parser is.
+// A table takes its unit from a caption in the block just before it.
+func (p *dartParser) emit(b Block) {
+	var blocks *[]Block
+	switch {
+	case p.inCover():
+		blocks = &p.doc.Cover
+	case len(p.open) > 0:
+		blocks = &p.open[len(p.open)-1].Blocks
+	default:
+		blocks = &p.doc.Blocks
+	}
+	if n := len(*blocks); b.Kind == BlockTable && n > 0 {
+		if prev := (*blocks)[n-1]; prev.Kind != BlockTable {
+			b.Table.Unit = ParseUnit(prev.Text)
+		}
+	}
+	*blocks = append(*blocks, b)
+}
+
+func (p *dartParser) inCover() bool {
+	for _, s := range p.stack {
+		if s == "COVER" {
+			return true
+		}
+	}
+	return false
+}
+
+// openSection starts a SECTION-N, nesting it under the closest open
+// section of a lower level.
+func (p *dartParser) openSection(name string) {
+	var level int
+	fmt.Sscanf(name[len("SECTION-"):], "%d", &level)
+	s := &Section{Level: level}
+	for len(p.open) > 0 && p.open[len(p.open)-1].Level >= level && level > 0 {
+		// A malformed file left a same-or-deeper section open; treat it
+		// as closed so the new one nests correctly.
+		p.open = p.open[:len(p.open)-1]
+	}
+	if len(p.open) > 0 {
+		parent := p.open[len(p.open)-1]
+		parent.Sections = append(parent.Sections, s)
+	} else {
+		p.doc.Sections = append(p.doc.Sections, s)
+	}
+	p.open = append(p.open, s)
+}
+
 // handleStart dispatches on element name.
 // Returns true if the handler consumed the element's end tag (via collectText / parseTable / skipTo).
-func (p *dartParser) handleStart(name string) (endConsumed bool) {
+func (p *dartParser) handleStart(name string, start xml.StartElement) (endConsumed bool) {
 	switch {
 
 	// ── skip: metadata & layout ──────────────────────────────────────────────
@@ -169,44 +531,65 @@ func (p *dartParser) handleStart(name string) (endConsumed bool) {
 		p.skipTo(name)
 		return true
 
+	// ── sections ─────────────────────────────────────────────────────────────
+	case strings.HasPrefix(name, "SECTION-"):
+		p.openSection(name)
+		return false
+
 	// ── transparent containers ───────────────────────────────────────────────
-	// SECTION-*, BODY, COVER, TABLE-GROUP, TABLE structure elements, SPAN, LIBRARY
+	// BODY, COVER, TABLE-GROUP, TABLE structure elements, SPAN, LIBRARY
 	// We let the token loop handle their children.
 	case name == "DOCUMENT" || name == "BODY" || name == "COVER" ||
 		name == "TABLE-GROUP" || name == "TBODY" || name == "THEAD" ||
-		name == "SPAN" || name == "LIBRARY" || strings.HasPrefix(name, "SECTION-"):
+		name == "SPAN" || name == "LIBRARY":
 		return false
 
 	// ── headings ─────────────────────────────────────────────────────────────
 	case name == "DOCUMENT-NAME":
 		if text := p.collectText(); text != "" {
-			fmt.Fprintf(&p.out, "# %s\n\n", text)
+			if p.doc.Title == "" {
+				p.doc.Title = text
+			} else {
+				p.emit(Block{Kind: BlockHeading, Level: 1, Text: text})
+			}
 		}
 		return true
 
 	case name == "COMPANY-NAME":
+		for _, a := range start.Attr {
+			if upcase(a.Name.Local) == "AREGCIK" && p.doc.CorpCode == "" {
+				p.doc.CorpCode = strings.TrimSpace(a.Value)
+			}
+		}
 		if text := p.collectText(); text != "" {
-			fmt.Fprintf(&p.out, "> %s\n\n", text)
+			if p.doc.Company == "" {
+				p.doc.Company = text
+			} else {
+				p.emit(Block{Kind: BlockParagraph, Text: text})
+			}
 		}
 		return true
 
 	case name == "COVER-TITLE":
 		if text := collapse(p.collectText()); text != "" {
-			fmt.Fprintf(&p.out, "# %s\n\n", text)
+			p.emit(Block{Kind: BlockHeading, Level: 1, Text: text})
 		}
 		return true
 
 	case name == "TITLE":
 		if text := collapse(p.collectText()); text != "" {
-			level := p.titleLevel()
-			fmt.Fprintf(&p.out, "%s %s\n\n", hashes(level), text)
+			if n := len(p.open); n > 0 && p.open[n-1].Title == "" && !p.inCover() {
+				p.open[n-1].Title = text
+			} else {
+				p.emit(Block{Kind: BlockHeading, Level: p.titleLevel(), Text: text})
+			}
 		}
 		return true
 
 	// ── IMG-CAPTION ──────────────────────────────────────────────────────────
 	case name == "IMG-CAPTION":
 		if text := collapse(p.collectText()); text != "" {
-			fmt.Fprintf(&p.out, "*%s*\n\n", text)
+			p.emit(Block{Kind: BlockCaption, Text: text})
 		}
 		return true
 
@@ -214,7 +597,7 @@ func (p *dartParser) handleStart(name string) (endConsumed bool) {
 	case name == "P":
 		text := strings.TrimSpace(p.collectText())
 		if text != "" {
-			fmt.Fprintf(&p.out, "%s\n\n", text)
+			p.emit(Block{Kind: BlockParagraph, Text: text})
 		}
 		return true
 
@@ -225,7 +608,9 @@ func (p *dartParser) handleStart(name string) (endConsumed bool) {
 
 	// ── table ────────────────────────────────────────────────────────────────
 	case name == "TABLE":
-		p.parseTable()
+		for _, b := range p.parseTable() {
+			p.emit(b)
+		}
 		return true
 
 	// ── ignore TR/TD at top level (shouldn't appear) ─────────────────────────
@@ -257,7 +642,7 @@ func (p *dartParser) collectText() string {
 	var buf strings.Builder
 	depth := 1
 	for depth > 0 {
-		tok, err := p.dec.Token()
+		tok, err := p.token()
 		if err != nil {
 			break
 		}
@@ -277,7 +662,7 @@ func (p *dartParser) collectText() string {
 func (p *dartParser) skipTo(name string) {
 	depth := 1
 	for depth > 0 {
-		tok, err := p.dec.Token()
+		tok, err := p.token()
 		if err != nil {
 			break
 		}
@@ -294,14 +679,17 @@ func (p *dartParser) skipTo(name string) {
 	}
 }
 
-// parseTable collects TABLE content and writes a markdown table.
-func (p *dartParser) parseTable() {
-	var rows [][]string
-	var curRow []string
+// parseTable collects TABLE content into a table block. Tables without
+// any text are dropped; a single-cell table becomes a paragraph, and a
+// leading "(단위 : …)" row becomes a paragraph before the table.
+func (p *dartParser) parseTable() []Block {
+	var rows [][]DocCell
+	var curRow []DocCell
 	depth := 1
+	inHead := false
 
 	for depth > 0 {
-		tok, err := p.dec.Token()
+		tok, err := p.token()
 		if err != nil {
 			break
 		}
@@ -315,17 +703,27 @@ func (p *dartParser) parseTable() {
 				curRow = nil
 			case "TD", "TH", "TU", "TE":
 				// Collect cell text (may contain nested P, SPAN, etc.)
-				text := p.collectText()
-				text = strings.TrimSpace(strings.ReplaceAll(text, "\n", " "))
-				text = strings.Join(strings.Fields(text), " ")
-				// Escape pipes inside cells
-				text = strings.ReplaceAll(text, "|", "｜")
-				curRow = append(curRow, text)
+				cell := DocCell{Header: elem == "TH" || inHead}
+				for _, a := range t.Attr {
+					switch upcase(a.Name.Local) {
+					case "COLSPAN":
+						cell.ColSpan = atoiSpan(a.Value, maxColSpan)
+					case "ROWSPAN":
+						cell.RowSpan = atoiSpan(a.Value, maxRowSpan)
+					}
+				}
+				cell.Text = collapse(p.collectText())
+				if v, ok := ParseNumber(cell.Text); ok {
+					cell.Value = &v
+				}
+				curRow = append(curRow, cell)
 			// column layout: skip (no content)
 			case "COL", "COLGROUP":
 				p.skipTo(elem)
-			// THEAD/TBODY are transparent structural wrappers; TR/TD inside will be handled
-			case "THEAD", "TBODY":
+			// THEAD/TBODY are structural wrappers; cells under THEAD are header cells
+			case "THEAD":
+				inHead = true
+			case "TBODY":
 				// transparent — do nothing
 			// embedded content: skip
 			case "IMAGE", "IMG", "LIBRARY", "SUMMARY":
@@ -336,8 +734,11 @@ func (p *dartParser) parseTable() {
 			switch elem {
 			case "TABLE":
 				depth--
+			case "THEAD":
+				inHead = false
 			case "TR":
-				if hasContent(curRow) {
+				// Rows without text are kept so ROWSPANs still line up.
+				if len(curRow) > 0 {
 					rows = append(rows, curRow)
 				}
 				curRow = nil
@@ -345,59 +746,45 @@ func (p *dartParser) parseTable() {
 		}
 	}
 
-	if len(rows) == 0 {
-		return
-	}
-
-	// If every cell in the table is empty or whitespace, skip it.
-	nonEmpty := 0
+	var filled [][]DocCell
 	for _, r := range rows {
-		for _, c := range r {
-			if c != "" {
-				nonEmpty++
-			}
+		if hasContent(r) {
+			filled = append(filled, r)
 		}
 	}
-	if nonEmpty == 0 {
-		return
+	if len(filled) == 0 {
+		return nil
 	}
 
 	// Single-cell, single-row → render as paragraph.
-	if len(rows) == 1 && len(rows[0]) == 1 {
-		fmt.Fprintf(&p.out, "%s\n\n", rows[0][0])
-		return
+	if len(filled) == 1 && len(filled[0]) == 1 {
+		return []Block{{Kind: BlockParagraph, Text: filled[0][0].Text}}
 	}
 
-	// Find max column count.
-	maxCols := 0
-	for _, r := range rows {
-		if len(r) > maxCols {
-			maxCols = len(r)
-		}
+	var out []Block
+	if caption := unitRow(rows[0]); caption != "" && len(filled) > 1 {
+		out = append(out, Block{Kind: BlockParagraph, Text: caption})
+		rows = rows[1:]
 	}
+	return append(out, Block{Kind: BlockTable, Table: &DocTable{Rows: rows}})
+}
 
-	writeRow := func(cells []string) {
-		p.out.WriteString("|")
-		for i := 0; i < maxCols; i++ {
-			cell := ""
-			if i < len(cells) {
-				cell = cells[i]
-			}
-			fmt.Fprintf(&p.out, " %s |", cell)
+// unitRow returns the text of a row holding only a unit caption.
+func unitRow(row []DocCell) string {
+	text := ""
+	for _, c := range row {
+		if c.Text == "" {
+			continue
 		}
-		p.out.WriteString("\n")
-	}
-
-	writeRow(rows[0])
-	p.out.WriteString("|")
-	for i := 0; i < maxCols; i++ {
-		p.out.WriteString("---|")
+		if text != "" {
+			return ""
+		}
+		text = c.Text
 	}
-	p.out.WriteString("\n")
-	for _, r := range rows[1:] {
-		writeRow(r)
+	if ParseUnit(text) == nil {
+		return ""
 	}
-	p.out.WriteString("\n")
+	return text
 }
 
 // ── helpers ──────────────────────────────────────────────────────────────────
@@ -419,11 +806,28 @@ func hashes(n int) string {
 }
 
 // hasContent reports whether any cell in row has non-empty text.
-func hasContent(row []string) bool {
+func hasContent(row []DocCell) bool {
 	for _, c := range row {
-		if c != "" {
+		if c.Text != "" {
 			return true
 		}
 	}
 	return false
 }
+
+// Span limits, as in HTML: a corrupt span must not blow up the grid.
+const (
+	maxColSpan = 1000
+	maxRowSpan = 65534
+)
+
+// atoiSpan parses a COLSPAN/ROWSPAN value; 1, invalid and absent are 0,
+// and values above limit are clamped.
+func atoiSpan(s string, limit int) int {
+	var n int
+	fmt.Sscanf(strings.TrimSpace(s), "%d", &n)
+	if n <= 1 {
+		return 0
+	}
+	return min(n, limit)
+}
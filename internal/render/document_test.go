@@ -0,0 +1,251 @@
+package render
+
+import (
+	"archive/zip"
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "testdata 골든 파일 갱신")
+
+// sampleDARTXML is a trimmed 사업보고서 in DART's XML dialect.
+const sampleDARTXML = `<?xml version="1.0" encoding="utf-8"?>
+<DOCUMENT>
+<DOCUMENT-NAME ACODE="11011">사업보고서</DOCUMENT-NAME>
+<FORMULA-VERSION ADATE="20240101">5.2</FORMULA-VERSION>
+<COMPANY-NAME AREGCIK="00126380">삼성전자주식회사</COMPANY-NAME>
+<SUMMARY><EXTRACTION ACODE="TOT_ASSETS">1</EXTRACTION></SUMMARY>
+<BODY>
+<COVER>
+<COVER-TITLE>사 업 보 고 서</COVER-TITLE>
+<TABLE><TBODY><TR><TD>회사명 :</TD><TD>삼성전자주식회사</TD></TR></TBODY></TABLE>
+</COVER>
+<SECTION-1>
+<TITLE ATOC="Y">I. 회사의 개요</TITLE>
+<SECTION-2>
+<TITLE ATOC="Y">1. 회사의 개요</TITLE>
+<P>당사는 1969년 설립되었습니다.</P>
+</SECTION-2>
+</SECTION-1>
+<SECTION-1>
+<TITLE ATOC="Y">II. 사업의 내용</TITLE>
+<SECTION-2>
+<TITLE ATOC="Y">2. 주요 제품</TITLE>
+<TABLE-GROUP><TABLE>
+<COLGROUP><COL WIDTH="100"/></COLGROUP>
+<THEAD><TR><TH ROWSPAN="2">부문</TH><TH COLSPAN="2">매출액</TH></TR>
+<TR><TH>금액</TH><TH>비중</TH></TR></THEAD>
+<TBODY><TR><TD>DX</TD><TE>1,000</TE><TE>60%</TE></TR></TBODY>
+</TABLE></TABLE-GROUP>
+</SECTION-2>
+</SECTION-1>
+</BODY>
+</DOCUMENT>`
+
+func TestParseDocumentTree(t *testing.T) {
+	doc := ParseDocument([]byte(sampleDARTXML))
+
+	if doc.Title != "사업보고서" || doc.Company != "삼성전자주식회사" || doc.CorpCode != "00126380" {
+		t.Errorf("제목/회사명/고유번호 = %q/%q/%q", doc.Title, doc.Company, doc.CorpCode)
+	}
+	if len(doc.Cover) != 2 || doc.Cover[0].Kind != BlockHeading || doc.Cover[1].Kind != BlockTable {
+		t.Errorf("표지 블록 = %+v", doc.Cover)
+	}
+	if len(doc.Sections) != 2 || len(doc.Sections[1].Sections) != 1 {
+		t.Fatalf("섹션 구조가 다릅니다: %+v", doc.Sections)
+	}
+
+	sub := doc.Sections[1].Sections[0]
+	if sub.Path != "II. 사업의 내용 > 2. 주요 제품" || sub.Level != 2 {
+		t.Errorf("하위 섹션 경로/레벨 = %q/%d", sub.Path, sub.Level)
+	}
+	if len(sub.Blocks) != 1 || sub.Blocks[0].Table == nil {
+		t.Fatalf("표 블록이 없습니다: %+v", sub.Blocks)
+	}
+	head := sub.Blocks[0].Table.Rows[0]
+	if !head[0].Header || head[0].RowSpan != 2 || head[1].ColSpan != 2 {
+		t.Errorf("병합 셀 정보가 다릅니다: %+v", head)
+	}
+
+	var paths []string
+	for _, e := range doc.TOC {
+		paths = append(paths, e.Path)
+	}
+	want := "I. 회사의 개요|I. 회사의 개요 > 1. 회사의 개요|II. 사업의 내용|II. 사업의 내용 > 2. 주요 제품"
+	if got := strings.Join(paths, "|"); got != want {
+		t.Errorf("목차 = %s", got)
+	}
+}
+
+func TestDocumentMarkdown(t *testing.T) {
+	md := dartXMLToMarkdown([]byte(sampleDARTXML))
+	for _, want := range []string{
+		"# 사업보고서\n\n> 삼성전자주식회사\n\n# 사 업 보 고 서\n\n",
+		"## I. 회사의 개요\n\n### 1. 회사의 개요\n\n당사는 1969년 설립되었습니다.\n\n",
+		"| 부문 | 매출액 / 금액 | 매출액 / 비중 |\n|---|---:|---:|\n| DX | 1,000 | 60% |\n",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("마크다운에 %q 가 없습니다:\n%s", want, md)
+		}
+	}
+}
+
+func TestTablePipeCell(t *testing.T) {
+	const xml = `<DOCUMENT><BODY><TABLE><THEAD><TR><TH>구분</TH><TH>내용</TH></TR></THEAD>
+<TBODY><TR><TD>A|B</TD><TD>x | y</TD></TR></TBODY></TABLE></BODY></DOCUMENT>`
+	doc := ParseDocument([]byte(xml))
+	if len(doc.Blocks) != 1 || doc.Blocks[0].Table == nil {
+		t.Fatalf("표 블록이 없습니다: %+v", doc.Blocks)
+	}
+	if got := doc.Blocks[0].Table.Rows[1][0].Text; got != "A|B" {
+		t.Errorf("셀 원문 = %q, 트리에는 \"|\"가 그대로 남아야 합니다", got)
+	}
+	if md := doc.Markdown(); !strings.Contains(md, "| A｜B | x ｜ y |\n") {
+		t.Errorf("셀의 \"|\"가 열을 늘렸습니다:\n%s", md)
+	}
+}
+
+func TestDocumentSelect(t *testing.T) {
+	doc := ParseDocument([]byte(sampleDARTXML))
+
+	for spec, want := range map[string]string{
+		"II":            "II. 사업의 내용",
+		"2.1":           "II. 사업의 내용 > 2. 주요 제품",
+		"사업의 내용/주요 제품":  "II. 사업의 내용 > 2. 주요 제품",
+		"주요 제품":         "II. 사업의 내용 > 2. 주요 제품",
+		"I/1.":          "I. 회사의 개요 > 1. 회사의 개요",
+		"ii. 사업의 내용/2":  "II. 사업의 내용 > 2. 주요 제품",
+		"회사의 개요/회사의 개요": "I. 회사의 개요 > 1. 회사의 개요",
+	} {
+		sel := doc.Select(spec)
+		if sel == nil || len(sel.Sections) != 1 {
+			t.Errorf("Select(%q) = %+v", spec, sel)
+			continue
+		}
+		if got := sel.Sections[0].Path; got != want {
+			t.Errorf("Select(%q) 경로 = %q, want %q", spec, got, want)
+		}
+	}
+
+	if sel := doc.Select("III"); sel != nil {
+		t.Errorf("없는 섹션이 선택되었습니다: %+v", sel.Sections)
+	}
+	if sel := doc.Select("I"); sel == nil || len(sel.Sections) != 1 || sel.Sections[0].Title != "I. 회사의 개요" {
+		t.Errorf("I 가 II 까지 선택하면 안 됩니다")
+	}
+
+	toc := TOCMarkdown([]*Document{doc})
+	if !strings.Contains(toc, "- `2` II. 사업의 내용\n  - `2.1` 2. 주요 제품\n") {
+		t.Errorf("목차 출력이 다릅니다:\n%s", toc)
+	}
+}
+
+// TestTableGolden renders the DART table samples in testdata/tables and
+// compares them with the .md next to each; run with -update to rewrite.
+func TestTableGolden(t *testing.T) {
+	files, err := filepath.Glob("testdata/tables/*.xml")
+	if err != nil || len(files) == 0 {
+		t.Fatalf("샘플이 없습니다: %v", err)
+	}
+	for _, f := range files {
+		t.Run(filepath.Base(f), func(t *testing.T) {
+			data, err := os.ReadFile(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := dartXMLToMarkdown(data)
+			golden := strings.TrimSuffix(f, ".xml") + ".md"
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("골든 파일을 읽을 수 없습니다 (-update 로 생성): %v", err)
+			}
+			if got != string(want) {
+				t.Errorf("%s 결과가 골든 파일과 다릅니다\ngot:\n%s\nwant:\n%s", f, got, want)
+			}
+		})
+	}
+}
+
+func testZIP(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, body := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Write([]byte(body))
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestDocumentFiles(t *testing.T) {
+	doc := func(title, pad string) string {
+		return "<DOCUMENT><DOCUMENT-NAME>" + title + "</DOCUMENT-NAME><BODY><P>" + title + pad + "</P></BODY></DOCUMENT>"
+	}
+	rcept := "20240312000736"
+	data := testZIP(t, map[string]string{
+		rcept + ".xml":       doc("사업보고서", ""),
+		rcept + "_00760.xml": doc("감사보고서", strings.Repeat(" 본문", 300)),
+		rcept + "_00761.xml": doc("연결감사보고서", strings.Repeat(" 본문", 200)),
+		rcept + "_00800.xml": doc("정관", strings.Repeat(" 본문", 10)),
+	})
+
+	files, err := ListDocumentFiles(data, rcept)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range files {
+		got = append(got, fmt.Sprintf("%d:%s", f.Index, f.Title))
+	}
+	if want := "1:사업보고서 2:감사보고서 3:연결감사보고서 4:정관"; strings.Join(got, " ") != want {
+		t.Errorf("파일 목록 = %v, want %s", got, want)
+	}
+
+	for _, tt := range []struct {
+		sel  FileSelection
+		want string
+	}{
+		{FileSelection{}, "사업보고서 감사보고서 연결감사보고서"},
+		{FileSelection{All: true}, "사업보고서 감사보고서 연결감사보고서 정관"},
+		{FileSelection{File: "4"}, "정관"},
+		{FileSelection{File: rcept + "_00761.xml"}, "연결감사보고서"},
+		{FileSelection{File: "정관"}, "정관"},
+		{FileSelection{File: "감사보고서"}, "감사보고서"},
+		{FileSelection{File: "연결"}, "연결감사보고서"},
+	} {
+		docs, err := ParseDocumentZIP(data, rcept, tt.sel)
+		if err != nil {
+			t.Errorf("%+v: %v", tt.sel, err)
+			continue
+		}
+		var titles []string
+		for _, d := range docs {
+			titles = append(titles, d.Title)
+		}
+		if strings.Join(titles, " ") != tt.want {
+			t.Errorf("%+v = %v, want %s", tt.sel, titles, tt.want)
+		}
+	}
+
+	for _, spec := range []string{"5", "0", "보고서", "없는파일"} {
+		if _, err := ParseDocumentZIP(data, rcept, FileSelection{File: spec}); err == nil {
+			t.Errorf("--file %s 는 오류여야 합니다", spec)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// LinkedBond is a normalised CB/BW/EB issuance decision.
+type LinkedBond struct {
+	Kind        string  `json:"kind"` // CB, BW, EB
+	RceptNo     string  `json:"rcept_no"`
+	BoardDate   string  `json:"board_date"`
+	Series      string  `json:"series"`
+	BondKind    string  `json:"bond_kind"`
+	FaceValue   string  `json:"face_value"`
+	CouponRate  string  `json:"coupon_rate"`
+	YieldToMat  string  `json:"yield_to_mat"`
+	Maturity    string  `json:"maturity"`
+	IssueMethod string  `json:"issue_method"`
+	Price       string  `json:"price"` // 전환가액 / 행사가액 / 교환가액
+	RefixFloor  string  `json:"refix_floor"`
+	PeriodStart string  `json:"period_start"`
+	PeriodEnd   string  `json:"period_end"`
+	ShareKind   string  `json:"share_kind"`
+	ShareCount  string  `json:"share_count"`
+	ShareRatio  string  `json:"share_ratio"` // 주식총수 대비 비율 as reported
+	LeadManager string  `json:"lead_manager"`
+	Detachable  string  `json:"detachable"`  // BW only
+	Outstanding int64   `json:"outstanding"` // 발행주식총수 at the time, 0 if unknown
+	Dilution    float64 `json:"dilution"`
+}
+
+// LinkedBondLabels maps kind codes to their Korean names.
+var LinkedBondLabels = map[string]string{
+	"CB": "전환사채",
+	"BW": "신주인수권부사채",
+	"EB": "교환사채",
+}
+
+var linkedBondPriceLabels = map[string]string{
+	"CB": "전환가액",
+	"BW": "행사가액",
+	"EB": "교환가액",
+}
+
+// linkedBondDilutionLabels names the Dilution column. An EB hands over shares
+// that already exist, so its figure is an exposure rather than a dilution.
+var linkedBondDilutionLabels = map[string]string{
+	"CB": "잠재 희석률",
+	"BW": "잠재 희석률",
+	"EB": "교환대상 주식 비율",
+}
+
+var linkedBondPeriodLabels = map[string]string{
+	"CB": "전환청구기간",
+	"BW": "행사기간",
+	"EB": "교환청구기간",
+}
+
+func linkedBondFromTerms(kind string, t api.BondDecisionTerms) LinkedBond {
+	return LinkedBond{
+		Kind:        kind,
+		RceptNo:     t.RceptNo,
+		BoardDate:   t.BoardDate,
+		Series:      t.Series,
+		BondKind:    t.BondKind,
+		FaceValue:   t.FaceValue,
+		CouponRate:  t.CouponRate,
+		YieldToMat:  t.YieldToMat,
+		Maturity:    t.Maturity,
+		IssueMethod: t.IssueMethod,
+		RefixFloor:  t.RefixFloor,
+		LeadManager: t.LeadManager,
+	}
+}
+
+// ConvertibleBonds normalises 전환사채권 발행결정 rows.
+func ConvertibleBonds(rows []api.ConvertibleBondDecision) []LinkedBond {
+	out := make([]LinkedBond, 0, len(rows))
+	for _, r := range rows {
+		b := linkedBondFromTerms("CB", r.BondDecisionTerms)
+		b.Price, b.ShareKind, b.ShareCount, b.ShareRatio = r.ConvPrice, r.ShareKind, r.ShareCount, r.ShareRatio
+		b.PeriodStart, b.PeriodEnd = r.PeriodStart, r.PeriodEnd
+		out = append(out, b)
+	}
+	return out
+}
+
+// WarrantBonds normalises 신주인수권부사채권 발행결정 rows.
+func WarrantBonds(rows []api.WarrantBondDecision) []LinkedBond {
+	out := make([]LinkedBond, 0, len(rows))
+	for _, r := range rows {
+		b := linkedBondFromTerms("BW", r.BondDecisionTerms)
+		b.Price, b.ShareKind, b.ShareCount, b.ShareRatio = r.ExercisePrice, r.ShareKind, r.ShareCount, r.ShareRatio
+		b.PeriodStart, b.PeriodEnd = r.PeriodStart, r.PeriodEnd
+		b.Detachable = r.Detachable
+		out = append(out, b)
+	}
+	return out
+}
+
+// ExchangeableBonds normalises 교환사채권 발행결정 rows.
+func ExchangeableBonds(rows []api.ExchangeableBondDecision) []LinkedBond {
+	out := make([]LinkedBond, 0, len(rows))
+	for _, r := range rows {
+		b := linkedBondFromTerms("EB", r.BondDecisionTerms)
+		b.Price, b.ShareKind, b.ShareCount, b.ShareRatio = r.ExchangePrice, r.TargetKind, r.ShareCount, r.ShareRatio
+		b.PeriodStart, b.PeriodEnd = r.PeriodStart, r.PeriodEnd
+		out = append(out, b)
+	}
+	return out
+}
+
+// SetOutstanding records the share count at the time of the decision and
+// derives the potential dilution. When the count is unknown the ratio
+// reported in the filing is used instead. EBs exchange into existing shares,
+// often of another company, so they always keep the filing's ratio.
+func (b *LinkedBond) SetOutstanding(n int64) {
+	if b.Kind == "EB" {
+		b.Dilution = parseRate(b.ShareRatio)
+		return
+	}
+	b.Outstanding = n
+	shares, ok := ParseAmount(b.ShareCount)
+	if ok && n > 0 {
+		b.Dilution = float64(shares) / float64(n) * 100
+		return
+	}
+	b.Dilution = parseRate(b.ShareRatio)
+}
+
+// DecisionYear returns the year of the board resolution, or 0 if unknown.
+func DecisionYear(date string) int {
+	d := digitsOnly(date)
+	if len(d) < 4 {
+		return 0
+	}
+	y, _ := strconv.Atoi(d[:4])
+	return y
+}
+
+// LinkedBondsMarkdown renders CB/BW/EB decisions as a summary table followed
+// by one detail table per issue.
+func LinkedBondsMarkdown(corpName, kind, startDate, endDate string, bonds []LinkedBond) string {
+	var sb strings.Builder
+	label := LinkedBondLabels[kind]
+	priceLabel := linkedBondPriceLabels[kind]
+	dilutionLabel := linkedBondDilutionLabels[kind]
+
+	fmt.Fprintf(&sb, "# %s %s 발행결정\n\n", corpName, label)
+	fmt.Fprintf(&sb, "%s ~ %s · 총 **%d**건\n\n", FormatDate(startDate), FormatDate(endDate), len(bonds))
+
+	var totalShares int64
+	var totalDilution float64
+	sb.WriteString("| 이사회결의일 | 회차 | 권면총액 | 표면/만기 이자율 | " + priceLabel + " | 발행 주식수 | " + dilutionLabel + " |\n")
+	sb.WriteString("|--------------|------|---:|---:|---:|---:|---:|\n")
+	for _, b := range bonds {
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %s |\n",
+			b.BoardDate, dash(b.Series), FormatAmount(b.FaceValue),
+			rates(b.CouponRate, b.YieldToMat), won(b.Price),
+			FormatAmountKRW(b.ShareCount), percent(b.Dilution))
+		if v, ok := ParseAmount(b.ShareCount); ok {
+			totalShares += v
+		}
+		totalDilution += b.Dilution
+	}
+	if len(bonds) > 1 {
+		fmt.Fprintf(&sb, "| **합계** | | | | | **%s** | **%s** |\n", commaInt(totalShares), percent(totalDilution))
+	}
+	sb.WriteString("\n")
+
+	for _, b := range bonds {
+		fmt.Fprintf(&sb, "## %s %s\n\n", dash(b.Series), dash(b.BondKind))
+		sb.WriteString("| 항목 | 내용 |\n|------|------|\n")
+		rows := []struct{ key, val string }{
+			{"이사회결의일", b.BoardDate},
+			{"권면총액", FormatAmountKRW(b.FaceValue) + "원"},
+			{"표면이자율", pct(b.CouponRate)},
+			{"만기이자율(YTM)", pct(b.YieldToMat)},
+			{"사채만기일", b.Maturity},
+			{"발행방법", b.IssueMethod},
+			{priceLabel, won(b.Price)},
+			{"리픽싱 최저 조정가액", won(b.RefixFloor)},
+			{linkedBondPeriodLabels[kind], period(b.PeriodStart, b.PeriodEnd)},
+			{"대상 주식", b.ShareKind},
+			{"발행(교환) 주식수", FormatAmountKRW(b.ShareCount) + "주"},
+			{"주식총수 대비(공시)", pct(b.ShareRatio)},
+			{"발행주식총수(직전 사업연도)", outstandingCell(b.Outstanding)},
+			{dilutionLabel, percent(b.Dilution)},
+			{"사채와 인수권 분리여부", b.Detachable},
+			{"대표주관회사", b.LeadManager},
+			{"접수번호", "`" + b.RceptNo + "`"},
+		}
+		for _, r := range rows {
+			if v := strings.TrimSpace(r.val); v != "" && v != "-" && v != "원" && v != "주" {
+				fmt.Fprintf(&sb, "| %s | %s |\n", r.key, v)
+			}
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// won formats a KRW price with a unit suffix.
+func won(s string) string {
+	if _, ok := ParseAmount(s); !ok {
+		return dash(s)
+	}
+	return FormatAmountKRW(s) + "원"
+}
+
+// pct appends "%" to a reported rate if it does not already carry one.
+func pct(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "-" || strings.HasSuffix(s, "%") {
+		return dash(s)
+	}
+	return s + "%"
+}
+
+func rates(coupon, ytm string) string {
+	return pct(coupon) + " / " + pct(ytm)
+}
+
+func percent(v float64) string {
+	if v == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.2f%%", v)
+}
+
+func period(start, end string) string {
+	if strings.TrimSpace(start) == "" && strings.TrimSpace(end) == "" {
+		return ""
+	}
+	return dash(start) + " ~ " + dash(end)
+}
+
+func outstandingCell(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return commaInt(n) + "주"
+}
+
+// IssuedShares returns 발행주식의 총수 from 주식의 총수 현황 rows, preferring
+// the 합계 row and falling back to the sum of the remaining rows.
+func IssuedShares(rows []api.StockTotal) int64 {
+	var sum int64
+	for _, r := range rows {
+		v, ok := ParseAmount(r.IssuedQty)
+		if !ok {
+			continue
+		}
+		if isTotalKind(r.Kind) {
+			return v
+		}
+		sum += v
+	}
+	return sum
+}
@@ -0,0 +1,61 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestLinkedBond_SetOutstanding(t *testing.T) {
+	b := LinkedBond{ShareCount: "1,000,000", ShareRatio: "3.5"}
+	b.SetOutstanding(20_000_000)
+	if b.Dilution != 5 {
+		t.Errorf("발행주식총수 기준 희석률 5%% 기대, got %f", b.Dilution)
+	}
+
+	b.SetOutstanding(0)
+	if b.Dilution != 3.5 {
+		t.Errorf("총수 미상이면 공시 비율 3.5%% 기대, got %f", b.Dilution)
+	}
+}
+
+func TestLinkedBond_ExchangeableUsesFilingRatio(t *testing.T) {
+	b := LinkedBond{Kind: "EB", ShareCount: "1,000,000", ShareRatio: "2.1"}
+	b.SetOutstanding(20_000_000)
+	if b.Dilution != 2.1 || b.Outstanding != 0 {
+		t.Errorf("교환사채는 공시 비율 2.1%% 기대, got %f (총수 %d)", b.Dilution, b.Outstanding)
+	}
+
+	md := LinkedBondsMarkdown("가나다", "EB", "20240101", "20241231", []LinkedBond{b})
+	if !strings.Contains(md, "| 교환대상 주식 비율 |") || strings.Contains(md, "희석") {
+		t.Errorf("교환사채는 희석률이 아닌 교환대상 비율로 표기 기대:\n%s", md)
+	}
+}
+
+func TestDecisionYear(t *testing.T) {
+	cases := map[string]int{
+		"2024년 03월 15일": 2024,
+		"2023-11-02":    2023,
+		"-":             0,
+	}
+	for in, want := range cases {
+		if got := DecisionYear(in); got != want {
+			t.Errorf("DecisionYear(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestIssuedShares(t *testing.T) {
+	rows := []api.StockTotal{
+		{Kind: "보통주", IssuedQty: "5,969,782,550"},
+		{Kind: "우선주", IssuedQty: "822,886,700"},
+		{Kind: "합계", IssuedQty: "6,792,669,250"},
+	}
+	if got := IssuedShares(rows); got != 6792669250 {
+		t.Errorf("합계 행 우선: got %d", got)
+	}
+	if got := IssuedShares(rows[:2]); got != 6792669250 {
+		t.Errorf("합계 행 없으면 합산: got %d", got)
+	}
+}
@@ -0,0 +1,166 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// ExtractedTable is a document table together with where it was found,
+// for the tables command and spreadsheet export.
+type ExtractedTable struct {
+	Index   int       `json:"index"`
+	File    string    `json:"file"`
+	Source  string    `json:"source"`
+	Path    string    `json:"path"`
+	Caption string    `json:"caption"`
+	Unit    string    `json:"unit"`
+	Rows    int       `json:"rows"`
+	Columns int       `json:"columns"`
+	Table   *DocTable `json:"-"`
+}
+
+// maxCaptionRunes bounds how long a paragraph before a table may be and
+// still count as its caption.
+const maxCaptionRunes = 100
+
+// ExtractTables lists every table of docs in document order, numbered from
+// 1. Path is the section path (the document title outside sections) and
+// Caption the short paragraph or heading just above the table, skipping a
+// unit caption such as "(단위 : 백만원)".
+func ExtractTables(docs []*Document) []ExtractedTable {
+	var out []ExtractedTable
+	add := func(d *Document, path string, blocks []Block) {
+		for i, b := range blocks {
+			if b.Kind != BlockTable || b.Table == nil {
+				continue
+			}
+			l := b.Table.Layout()
+			t := ExtractedTable{
+				Index:   len(out) + 1,
+				Source:  d.Name,
+				Path:    path,
+				Caption: tableCaption(blocks[:i]),
+				Rows:    len(l.Rows),
+				Columns: len(l.Header),
+				Table:   b.Table,
+			}
+			if b.Table.Unit != nil {
+				t.Unit = b.Table.Unit.Text
+			}
+			t.File = t.fileName()
+			out = append(out, t)
+		}
+	}
+	for _, d := range docs {
+		add(d, d.Title, d.Cover)
+		add(d, d.Title, d.Blocks)
+		var walk func(secs []*Section)
+		walk = func(secs []*Section) {
+			for _, s := range secs {
+				add(d, s.Path, s.Blocks)
+				walk(s.Sections)
+			}
+		}
+		walk(d.Sections)
+	}
+	return out
+}
+
+// tableCaption looks back over the blocks before a table for its title.
+func tableCaption(before []Block) string {
+	for i := len(before) - 1; i >= 0 && i >= len(before)-3; i-- {
+		b := before[i]
+		if b.Kind == BlockTable {
+			break
+		}
+		text := collapse(b.Text)
+		if u := ParseUnit(text); u != nil && strings.HasPrefix(strings.TrimLeft(text, "([ "), "단") {
+			continue
+		}
+		if text == "" || utf8.RuneCountInString(text) > maxCaptionRunes {
+			break
+		}
+		return text
+	}
+	return ""
+}
+
+// Matches reports whether q appears in the table's caption or section
+// path, ignoring case and spacing ("요약 재무" matches "요약재무정보").
+func (t ExtractedTable) Matches(q string) bool {
+	compact := func(s string) string { return strings.ReplaceAll(normTitle(s), " ", "") }
+	q = compact(q)
+	return strings.Contains(compact(t.Caption), q) || strings.Contains(compact(t.Path), q)
+}
+
+var unsafeFileChars = regexp.MustCompile(`[\\/:*?"<>|\s]+`)
+
+// fileName is the table's CSV name: its number and section path,
+// e.g. "012_II._사업의_내용_2._주요_제품.csv".
+func (t ExtractedTable) fileName() string {
+	slug := unsafeFileChars.ReplaceAllString(strings.ReplaceAll(t.Path, " > ", "_"), "_")
+	slug = strings.Trim(slug, "_.")
+	if utf8.RuneCountInString(slug) > 60 {
+		slug = strings.TrimRight(string([]rune(slug)[:60]), "_.")
+	}
+	if slug == "" {
+		return fmt.Sprintf("%03d.csv", t.Index)
+	}
+	return fmt.Sprintf("%03d_%s.csv", t.Index, slug)
+}
+
+// Export converts the table for csv/xlsx: the resolved layout with
+// numeric cells as numbers, named after its section and unit.
+func (t ExtractedTable) Export() Table {
+	l := t.Table.Layout()
+	name := t.Path
+	if i := strings.LastIndex(name, " > "); i >= 0 {
+		name = name[i+3:]
+	}
+	if t.Unit != "" {
+		name += " [" + t.Unit + "]"
+	}
+	out := Table{Name: name, Header: l.Header}
+	for r, row := range l.Rows {
+		cells := make([]any, len(row))
+		for c, text := range row {
+			switch {
+			case l.Values[r][c] != nil:
+				cells[c] = *l.Values[r][c]
+			case text != "":
+				cells[c] = text
+			}
+		}
+		out.Rows = append(out.Rows, cells)
+	}
+	return out
+}
+
+// TableManifest is tables command output: the extracted tables and the
+// CSV file each was written to.
+type TableManifest []ExtractedTable
+
+// Tables implements Tabular.
+func (m TableManifest) Tables() []Table {
+	t := Table{Name: "표 목록", Header: []string{"번호", "파일", "원본", "섹션", "캡션", "단위", "행", "열"}}
+	for _, e := range m {
+		t.Rows = append(t.Rows, []any{int64(e.Index), e.File, e.Source, e.Path, e.Caption, e.Unit, int64(e.Rows), int64(e.Columns)})
+	}
+	return []Table{t}
+}
+
+// TableManifestMarkdown lists the extracted tables and where they went.
+func TableManifestMarkdown(rceptNo, dir string, m TableManifest) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "## 표 추출 (%s)\n\n", rceptNo)
+	fmt.Fprintf(&sb, "%d개 표를 `%s`에 저장했습니다.\n\n", len(m), dir)
+	sb.WriteString("| # | 파일 | 섹션 | 캡션 | 단위 | 크기 |\n")
+	sb.WriteString("|---:|---|---|---|---|---:|\n")
+	for _, e := range m {
+		fmt.Fprintf(&sb, "| %d | %s | %s | %s | %s | %d×%d |\n",
+			e.Index, e.File, escapePipes(e.Path), escapePipes(e.Caption), escapePipes(e.Unit), e.Rows, e.Columns)
+	}
+	return sb.String()
+}
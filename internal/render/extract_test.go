@@ -0,0 +1,49 @@
+package render
+
+import "testing"
+
+func TestExtractTables(t *testing.T) {
+	const xml = `<DOCUMENT><DOCUMENT-NAME>사업보고서</DOCUMENT-NAME><BODY>
+<SECTION-1><TITLE ATOC="Y">III. 재무에 관한 사항</TITLE>
+<SECTION-2><TITLE ATOC="Y">1. 요약재무정보</TITLE>
+<P>가. 요약연결재무정보</P>
+<TABLE><TBODY><TR><TD>(단위 : 백만원)</TD></TR></TBODY></TABLE>
+<TABLE><THEAD><TR><TH>구 분</TH><TH>제56기</TH></TR></THEAD>
+<TBODY><TR><TD>자산총계</TD><TE>1,000,000</TE></TR><TR><TD>부채총계</TD><TE>(300)</TE></TR></TBODY></TABLE>
+</SECTION-2>
+<SECTION-2><TITLE ATOC="Y">2. 배당/기타</TITLE>
+<TABLE><TBODY><TR><TD>주당배당금</TD><TD>361</TD></TR></TBODY></TABLE>
+</SECTION-2>
+</SECTION-1></BODY></DOCUMENT>`
+
+	doc := ParseDocument([]byte(xml))
+	doc.Name = "1.xml"
+	tables := ExtractTables([]*Document{doc})
+	if len(tables) != 2 {
+		t.Fatalf("표 %d개, 2개를 기대했습니다: %+v", len(tables), tables)
+	}
+
+	first := tables[0]
+	if first.Caption != "가. 요약연결재무정보" || first.Unit != "백만원" {
+		t.Errorf("캡션/단위 = %q/%q", first.Caption, first.Unit)
+	}
+	if first.File != "001_III._재무에_관한_사항_1._요약재무정보.csv" {
+		t.Errorf("파일 이름 = %q", first.File)
+	}
+	if first.Rows != 2 || first.Columns != 2 || first.Source != "1.xml" {
+		t.Errorf("크기/원본 = %d×%d %q", first.Rows, first.Columns, first.Source)
+	}
+	if !first.Matches("요약 재무") || first.Matches("배당") {
+		t.Error("--match 가 캡션·섹션 경로로 걸러지지 않습니다")
+	}
+
+	// A "/" in the section title must not leave the output directory.
+	if got := tables[1].File; got != "002_III._재무에_관한_사항_2._배당_기타.csv" {
+		t.Errorf("파일 이름 = %q", got)
+	}
+
+	ex := first.Export()
+	if ex.Name != "1. 요약재무정보 [백만원]" || ex.Rows[1][1] != float64(-300) {
+		t.Errorf("내보내기 = %q %v", ex.Name, ex.Rows)
+	}
+}
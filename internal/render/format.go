@@ -0,0 +1,72 @@
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// Output formats selectable with the global --format flag.
+const (
+	FormatText = "text" // glamour-rendered markdown (default)
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+	FormatCSV  = "csv"  // tabular commands only
+	FormatTSV  = "tsv"  // tabular commands only
+	FormatXLSX = "xlsx" // tabular commands only
+)
+
+// Formats lists the accepted --format values.
+var Formats = []string{FormatText, FormatMarkdown, FormatJSON, FormatYAML, FormatCSV, FormatTSV, FormatXLSX}
+
+// ValidFormat reports whether f is an accepted --format value.
+func ValidFormat(f string) bool {
+	for _, v := range Formats {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
+
+// EncodeJSON writes v as indented JSON. HTML characters are left unescaped
+// so URLs and Korean text stay readable.
+func EncodeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// EncodeYAML writes v as YAML. The value goes through JSON first so YAML
+// output uses the same field names, key order and typed numbers.
+func EncodeYAML(w io.Writer, v any) error {
+	var buf bytes.Buffer
+	if err := EncodeJSON(&buf, v); err != nil {
+		return err
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(buf.Bytes(), &node); err != nil {
+		return fmt.Errorf("YAML 변환 실패: %w", err)
+	}
+	blockStyle(&node)
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(&node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// blockStyle clears the flow/quoted styles a JSON document parses with, so
+// the encoder emits block YAML and quotes strings only where needed.
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
@@ -0,0 +1,43 @@
+package render
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncodeYAMLKeepsOrderAndTypes(t *testing.T) {
+	v := struct {
+		Name   string `json:"name"`
+		Code   string `json:"code"`
+		Amount int64  `json:"amount"`
+		Tags   []string
+	}{"삼성전자", "005930", 1234, []string{"a"}}
+
+	var buf bytes.Buffer
+	if err := EncodeYAML(&buf, v); err != nil {
+		t.Fatal(err)
+	}
+	want := "name: 삼성전자\ncode: \"005930\"\namount: 1234\nTags:\n  - a\n"
+	if got := buf.String(); got != want {
+		t.Errorf("EncodeYAML =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteMarkdownFrontMatter(t *testing.T) {
+	md := "# 제목\n\n| a | b |\n|---|---|\n| 1 | 2 |\n"
+	fm := FrontMatter{
+		Command:   "dartcli list",
+		Args:      []string{"삼성전자"},
+		CorpCode:  "00126380",
+		Params:    map[string]string{"days": "30", "type": "A"},
+		FetchedAt: "2026-01-02T03:04:05+09:00",
+	}
+	var buf bytes.Buffer
+	if err := WriteMarkdown(&buf, fm, md); err != nil {
+		t.Fatal(err)
+	}
+	want := "---\ncommand: dartcli list\nargs:\n  - 삼성전자\ncorp_code: \"00126380\"\nparams:\n  days: \"30\"\n  type: A\nfetched_at: \"2026-01-02T03:04:05+09:00\"\n---\n\n" + md
+	if got := buf.String(); got != want {
+		t.Errorf("WriteMarkdown =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,41 @@
+package render
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// FormatMarkdown writes the markdown source as-is, bypassing glamour, with
+// YAML front matter describing the query.
+const FormatMarkdown = "markdown"
+
+// FrontMatter describes how a markdown document was produced so saved
+// files are self-describing.
+type FrontMatter struct {
+	Command   string            `json:"command"`
+	Args      []string          `json:"args,omitempty"`
+	CorpCode  string            `json:"corp_code,omitempty"`
+	CorpName  string            `json:"corp_name,omitempty"`
+	RceptNo   string            `json:"rcept_no,omitempty"`
+	Params    map[string]string `json:"params,omitempty"`
+	FetchedAt string            `json:"fetched_at"`
+}
+
+// WriteMarkdown writes md preceded by fm as a "---" delimited YAML block.
+func WriteMarkdown(w io.Writer, fm FrontMatter, md string) error {
+	if fm.FetchedAt == "" {
+		fm.FetchedAt = time.Now().Format(time.RFC3339)
+	}
+	var buf bytes.Buffer
+	buf.WriteString("---\n")
+	if err := EncodeYAML(&buf, fm); err != nil {
+		return err
+	}
+	buf.WriteString("---\n\n")
+	buf.WriteString(md)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return nil
+}
@@ -99,3 +99,25 @@ func CorpClassLabel(cls string) string {
 		return cls
 	}
 }
+
+// ParseAmount parses a DART amount string ("1,234", "-", "") into an integer.
+// The boolean is false when s holds no number.
+func ParseAmount(s string) (int64, bool) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, ",", ""))
+	if s == "" || s == "-" {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return v, true
+}
+
+// DARTViewBaseURL is the DART website viewer URL prefix for a 접수번호.
+const DARTViewBaseURL = "https://dart.fss.or.kr/dsaf001/main.do?rcpNo="
+
+// DARTViewURL returns the DART website link for a 접수번호.
+func DARTViewURL(rceptNo string) string {
+	return DARTViewBaseURL + rceptNo
+}
@@ -0,0 +1,126 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// majorStakeThreshold is the 5% rule reporting threshold.
+const majorStakeThreshold = 5.0
+
+// HolderPosition is a reporter's latest position under the 5% rule.
+type HolderPosition struct {
+	Reporter    string  `json:"reporter"`
+	RceptDt     string  `json:"rcept_dt"`
+	RceptNo     string  `json:"rcept_no"`
+	Shares      string  `json:"shares"`
+	StakeRate   float64 `json:"stake_rate"`
+	StakeChange string  `json:"stake_change"`
+	Reports     int     `json:"reports"`
+}
+
+// LatestHoldings rolls 대량보유 reports up into each reporter's most recent
+// position, sorted by stake descending.
+func LatestHoldings(reports []api.MajorStockReport) []HolderPosition {
+	byReporter := map[string]*HolderPosition{}
+	for _, r := range reports {
+		key := strings.TrimSpace(r.Reporter)
+		pos := byReporter[key]
+		if pos == nil {
+			pos = &HolderPosition{Reporter: key}
+			byReporter[key] = pos
+		}
+		pos.Reports++
+		if r.RceptDt+r.RceptNo > pos.RceptDt+pos.RceptNo {
+			pos.RceptDt = r.RceptDt
+			pos.RceptNo = r.RceptNo
+			pos.Shares = r.Shares
+			pos.StakeRate = parseRate(r.StakeRate)
+			pos.StakeChange = r.StakeChange
+		}
+	}
+
+	out := make([]HolderPosition, 0, len(byReporter))
+	for _, p := range byReporter {
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].StakeRate != out[j].StakeRate {
+			return out[i].StakeRate > out[j].StakeRate
+		}
+		return out[i].Reporter < out[j].Reporter
+	})
+	return out
+}
+
+// NewestReports returns a copy of reports ordered newest filing first.
+func NewestReports(reports []api.MajorStockReport) []api.MajorStockReport {
+	sorted := make([]api.MajorStockReport, len(reports))
+	copy(sorted, reports)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].RceptDt+sorted[i].RceptNo > sorted[j].RceptDt+sorted[j].RceptNo
+	})
+	return sorted
+}
+
+// HoldingsMarkdown renders the per-holder roll-up followed by every report.
+func HoldingsMarkdown(corpName string, reports []api.MajorStockReport) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 대량보유 상황보고\n\n", corpName)
+	fmt.Fprintf(&sb, "총 **%d**건\n\n", len(reports))
+
+	sb.WriteString("## 보고자별 최근 보유현황\n\n")
+	sb.WriteString("| 보고자 | 최근 보고일 | 보유주식수 | 보유비율 | 증감 | 보고 횟수 |\n")
+	sb.WriteString("|--------|-------------|---:|---:|---:|---:|\n")
+	for _, p := range LatestHoldings(reports) {
+		rate := fmt.Sprintf("%.2f%%", p.StakeRate)
+		if p.StakeRate < majorStakeThreshold {
+			rate += " (5% 미만)"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %d |\n",
+			p.Reporter, FormatDate(p.RceptDt), FormatAmountKRW(p.Shares),
+			rate, signed(p.StakeChange), p.Reports)
+	}
+	sb.WriteString("\n")
+
+	sb.WriteString("## 보고 내역\n\n")
+	sb.WriteString("| 보고일 | 보고자 | 보고구분 | 보유주식수 | 주식 증감 | 보유비율 | 비율 증감 | 보고사유 | 접수번호 |\n")
+	sb.WriteString("|--------|--------|----------|---:|---:|---:|---:|----------|----------|\n")
+	for _, r := range NewestReports(reports) {
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s%% | %s | %s | `%s` |\n",
+			FormatDate(r.RceptDt), r.Reporter, r.ReportType,
+			FormatAmountKRW(r.Shares), signed(FormatAmountKRW(r.SharesChange)),
+			r.StakeRate, signed(r.StakeChange), collapse(r.Reason), r.RceptNo)
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+// parseRate parses a percentage string such as "7.25" or "7.25%".
+func parseRate(s string) float64 {
+	s = strings.TrimSpace(strings.TrimSuffix(strings.ReplaceAll(s, ",", ""), "%"))
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// signed prefixes positive numeric strings with "+" so changes read clearly.
+func signed(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || s == "-" {
+		return "-"
+	}
+	if s[0] != '-' && s[0] != '+' {
+		if v := parseRate(s); v > 0 {
+			return "+" + s
+		}
+	}
+	return s
+}
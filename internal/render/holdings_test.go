@@ -0,0 +1,66 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestLatestHoldings(t *testing.T) {
+	reports := []api.MajorStockReport{
+		{Reporter: "국민연금공단", RceptDt: "20240105", RceptNo: "20240105000100", Shares: "1,000", StakeRate: "7.10"},
+		{Reporter: "국민연금공단", RceptDt: "20240310", RceptNo: "20240310000050", Shares: "1,200", StakeRate: "8.25"},
+		// Same day, later receipt number wins.
+		{Reporter: "국민연금공단", RceptDt: "20240310", RceptNo: "20240310000200", Shares: "1,300", StakeRate: "9.00"},
+		{Reporter: " 블랙록 ", RceptDt: "20240201", RceptNo: "20240201000001", Shares: "600", StakeRate: "4.90%"},
+	}
+
+	got := LatestHoldings(reports)
+	if len(got) != 2 {
+		t.Fatalf("보고자 2명 기대, got %d", len(got))
+	}
+	nps := got[0]
+	if nps.Reporter != "국민연금공단" || nps.RceptNo != "20240310000200" || nps.Shares != "1,300" {
+		t.Errorf("최근 접수번호 기준 최신 보유현황 기대, got %+v", nps)
+	}
+	if nps.StakeRate != 9 || nps.Reports != 3 {
+		t.Errorf("보유비율 9%%, 보고 3건 기대, got %f, %d", nps.StakeRate, nps.Reports)
+	}
+	if got[1].Reporter != "블랙록" || got[1].StakeRate != 4.9 {
+		t.Errorf("보고자명 공백 제거 및 비율 파싱 기대, got %+v", got[1])
+	}
+}
+
+func TestParseRate(t *testing.T) {
+	cases := map[string]float64{
+		"7.25":   7.25,
+		"7.25%":  7.25,
+		" -0.5 ": -0.5,
+		"1,005":  1005,
+		"-":      0,
+		"":       0,
+	}
+	for in, want := range cases {
+		if got := parseRate(in); got != want {
+			t.Errorf("parseRate(%q) = %f, want %f", in, got, want)
+		}
+	}
+}
+
+func TestSigned(t *testing.T) {
+	cases := map[string]string{
+		"1.20":  "+1.20",
+		"1,000": "+1,000",
+		"-0.35": "-0.35",
+		"+2":    "+2",
+		"0.00":  "0.00",
+		"":      "-",
+		" - ":   "-",
+		"해당없음":  "해당없음",
+	}
+	for in, want := range cases {
+		if got := signed(in); got != want {
+			t.Errorf("signed(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package render
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// InsiderTrade is one 소유보고 row with its change relative to prior holdings.
+type InsiderTrade struct {
+	api.ExecutiveStockReport
+	Change    int64   `json:"change"`
+	Prior     int64   `json:"prior"`
+	ChangePct float64 `json:"change_pct"` // |Change| / Prior * 100
+	New       bool    `json:"new"`        // no holding before this report
+	Large     bool    `json:"large"`
+}
+
+// InsiderNet aggregates net buying and selling for one insider or month.
+type InsiderNet struct {
+	Key    string `json:"key"`
+	Bought int64  `json:"bought"`
+	Sold   int64  `json:"sold"`
+	Net    int64  `json:"net"`
+	Trades int    `json:"trades"`
+}
+
+// InsiderSummary is the analysed view of a set of 소유보고 reports.
+type InsiderSummary struct {
+	Trades    []InsiderTrade `json:"trades"`     // newest first
+	ByInsider []InsiderNet   `json:"by_insider"` // most net selling first
+	ByMonth   []InsiderNet   `json:"by_month"`   // chronological
+	Threshold float64        `json:"threshold"`
+}
+
+// InsidersSince keeps the reports received on or after since (YYYYMMDD).
+// Dates are compared digits-only, so "2024-03-15" and "20240315" agree.
+func InsidersSince(reports []api.ExecutiveStockReport, since string) []api.ExecutiveStockReport {
+	var out []api.ExecutiveStockReport
+	for _, r := range reports {
+		if digitsOnly(r.RceptDt) >= since {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// SummarizeInsiders computes per-report changes and per-insider / per-month
+// net flows. A trade is flagged as large when it moves the reporter's prior
+// holding by at least thresholdPct percent.
+func SummarizeInsiders(reports []api.ExecutiveStockReport, thresholdPct float64) InsiderSummary {
+	s := InsiderSummary{Threshold: thresholdPct}
+	insiders := map[string]*InsiderNet{}
+	months := map[string]*InsiderNet{}
+
+	add := func(m map[string]*InsiderNet, key string, change int64) {
+		n := m[key]
+		if n == nil {
+			n = &InsiderNet{Key: key}
+			m[key] = n
+		}
+		n.Trades++
+		n.Net += change
+		if change > 0 {
+			n.Bought += change
+		} else {
+			n.Sold -= change
+		}
+	}
+
+	for _, r := range reports {
+		held, _ := ParseAmount(r.Shares)
+		change, _ := ParseAmount(r.SharesChange)
+		t := InsiderTrade{ExecutiveStockReport: r, Change: change, Prior: held - change}
+		switch {
+		case change == 0:
+		case t.Prior <= 0:
+			t.New = true
+			t.Large = true
+		default:
+			t.ChangePct = math.Abs(float64(change)) / float64(t.Prior) * 100
+			t.Large = t.ChangePct >= thresholdPct
+		}
+		s.Trades = append(s.Trades, t)
+
+		add(insiders, strings.TrimSpace(r.Reporter), change)
+		if d := digitsOnly(r.RceptDt); len(d) >= 6 {
+			add(months, d[:4]+"-"+d[4:6], change)
+		}
+	}
+
+	sort.SliceStable(s.Trades, func(i, j int) bool {
+		return s.Trades[i].RceptDt+s.Trades[i].RceptNo > s.Trades[j].RceptDt+s.Trades[j].RceptNo
+	})
+	for _, n := range insiders {
+		s.ByInsider = append(s.ByInsider, *n)
+	}
+	sort.Slice(s.ByInsider, func(i, j int) bool {
+		if s.ByInsider[i].Net != s.ByInsider[j].Net {
+			return s.ByInsider[i].Net < s.ByInsider[j].Net
+		}
+		return s.ByInsider[i].Key < s.ByInsider[j].Key
+	})
+	for _, n := range months {
+		s.ByMonth = append(s.ByMonth, *n)
+	}
+	sort.Slice(s.ByMonth, func(i, j int) bool { return s.ByMonth[i].Key < s.ByMonth[j].Key })
+	return s
+}
+
+// InsidersMarkdown renders the insider summary.
+func InsidersMarkdown(corpName string, days int, s InsiderSummary) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 임원·주요주주 소유보고\n\n", corpName)
+	large := 0
+	for _, t := range s.Trades {
+		if t.Large {
+			large++
+		}
+	}
+	fmt.Fprintf(&sb, "최근 %d일 · 총 **%d**건 · 대규모 변동(보유량 대비 %.0f%% 이상) **%d**건\n\n",
+		days, len(s.Trades), s.Threshold, large)
+
+	sb.WriteString("## 보고자별 순매수\n\n")
+	writeNetTable(&sb, "보고자", s.ByInsider)
+
+	sb.WriteString("## 월별 순매수\n\n")
+	writeNetTable(&sb, "월", s.ByMonth)
+
+	sb.WriteString("## 보고 내역\n\n")
+	sb.WriteString("| 보고일 | 보고자 | 직위 | 주요주주 | 변동 | 변동률 | 보유주식수 | 보유비율 | 접수번호 |\n")
+	sb.WriteString("|--------|--------|------|----------|---:|---:|---:|---:|----------|\n")
+	for _, t := range s.Trades {
+		pct := "-"
+		switch {
+		case t.New:
+			pct = "신규"
+		case t.Change != 0:
+			pct = fmt.Sprintf("%.1f%%", t.ChangePct)
+		}
+		if t.Large {
+			pct = "**" + pct + "** ⚠"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %s | %s%% | `%s` |\n",
+			FormatDate(t.RceptDt), t.Reporter, dash(t.Position), dash(t.MajorShareholder),
+			signedInt(t.Change), pct, FormatAmountKRW(t.Shares), t.StakeRate, t.RceptNo)
+	}
+	sb.WriteString("\n")
+	return sb.String()
+}
+
+func writeNetTable(sb *strings.Builder, label string, rows []InsiderNet) {
+	fmt.Fprintf(sb, "| %s | 매수 | 매도 | 순매수 | 건수 |\n", label)
+	sb.WriteString("|------|---:|---:|---:|---:|\n")
+	for _, n := range rows {
+		fmt.Fprintf(sb, "| %s | %s | %s | %s | %d |\n",
+			n.Key, amountCell(n.Bought), amountCell(n.Sold), signedInt(n.Net), n.Trades)
+	}
+	sb.WriteString("\n")
+}
+
+// signedInt formats v with thousands separators and an explicit sign.
+func signedInt(v int64) string {
+	switch {
+	case v > 0:
+		return "+" + commaInt(v)
+	case v < 0:
+		return commaInt(v)
+	default:
+		return "-"
+	}
+}
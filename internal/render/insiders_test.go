@@ -0,0 +1,58 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestSummarizeInsiders(t *testing.T) {
+	reports := []api.ExecutiveStockReport{
+		{RceptNo: "1", RceptDt: "20240105", Reporter: "홍길동", Shares: "9,000", SharesChange: "-1,000"},
+		{RceptNo: "2", RceptDt: "20240120", Reporter: "홍길동", Shares: "9,500", SharesChange: "500"},
+		{RceptNo: "3", RceptDt: "20240210", Reporter: "김철수", Shares: "100", SharesChange: "100"},
+		{RceptNo: "4", RceptDt: "20240215", Reporter: "김철수", Shares: "99", SharesChange: "-1"},
+	}
+	s := SummarizeInsiders(reports, 10)
+
+	if s.Trades[0].RceptNo != "4" {
+		t.Errorf("최신순 정렬 기대, 첫 행 %s", s.Trades[0].RceptNo)
+	}
+	large := map[string]bool{}
+	for _, tr := range s.Trades {
+		large[tr.RceptNo] = tr.Large
+	}
+	// 1: 1,000/10,000 = 10% → 대규모, 2: 500/9,000 ≈ 5.6%, 3: 신규, 4: 1%
+	want := map[string]bool{"1": true, "2": false, "3": true, "4": false}
+	for k, v := range want {
+		if large[k] != v {
+			t.Errorf("보고 %s: Large=%v, want %v", k, large[k], v)
+		}
+	}
+
+	if s.ByInsider[0].Key != "홍길동" || s.ByInsider[0].Net != -500 ||
+		s.ByInsider[0].Bought != 500 || s.ByInsider[0].Sold != 1000 {
+		t.Errorf("홍길동 순매수 -500 기대, got %+v", s.ByInsider[0])
+	}
+	if len(s.ByMonth) != 2 || s.ByMonth[0].Key != "2024-01" || s.ByMonth[1].Net != 99 {
+		t.Errorf("월별 집계 오류: %+v", s.ByMonth)
+	}
+}
+
+func TestInsidersDashedDates(t *testing.T) {
+	reports := []api.ExecutiveStockReport{
+		{RceptNo: "1", RceptDt: "2024-01-31", Reporter: "홍길동", Shares: "100", SharesChange: "100"},
+		{RceptNo: "2", RceptDt: "2024-02-01", Reporter: "홍길동", Shares: "50", SharesChange: "-50"},
+		{RceptNo: "3", RceptDt: "20240215", Reporter: "홍길동", Shares: "60", SharesChange: "10"},
+	}
+
+	recent := InsidersSince(reports, "20240201")
+	if len(recent) != 2 || recent[0].RceptNo != "2" {
+		t.Fatalf("구분자 있는 접수일도 기준일과 비교 기대, got %+v", recent)
+	}
+
+	s := SummarizeInsiders(reports, 10)
+	if len(s.ByMonth) != 2 || s.ByMonth[0].Key != "2024-01" || s.ByMonth[1].Key != "2024-02" || s.ByMonth[1].Net != -40 {
+		t.Errorf("구분자 제거 후 월별 집계 기대: %+v", s.ByMonth)
+	}
+}
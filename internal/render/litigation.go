@@ -0,0 +1,153 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// Lawsuit is one 소송 등의 제기 filing with its claim amount parsed out.
+type Lawsuit struct {
+	RceptNo     string  `json:"rcept_no"`
+	FiledDate   string  `json:"filed_date"`
+	CaseName    string  `json:"case_name"`
+	Plaintiff   string  `json:"plaintiff"`
+	Court       string  `json:"court"`
+	Claim       string  `json:"claim"`
+	Plan        string  `json:"plan"`
+	ClaimAmount int64   `json:"claim_amount"` // 0 when the claim states no KRW amount
+	EquityPct   float64 `json:"equity_pct"`   // claim as a percentage of 자본총계; 0 when unknown
+}
+
+// Equity is the 자본총계 used to size claims, with where it came from.
+type Equity struct {
+	Amount int64  `json:"amount"`
+	Source string `json:"source"` // e.g. "2025년 반기 연결"
+}
+
+// EquityFromFinance picks 자본총계 from a GetFinance result, preferring
+// consolidated statements.
+func EquityFromFinance(items []api.FinanceAccount) (int64, string) {
+	var ofs int64
+	for _, it := range items {
+		if strings.ReplaceAll(it.AccountNm, " ", "") != "자본총계" {
+			continue
+		}
+		v, ok := ParseAmount(it.Thstrm_amount)
+		if !ok {
+			continue
+		}
+		if it.FsDiv == "OFS" {
+			ofs = v
+			continue
+		}
+		return v, "CFS"
+	}
+	if ofs != 0 {
+		return ofs, "OFS"
+	}
+	return 0, ""
+}
+
+var (
+	claimAmountRe = regexp.MustCompile(`(?:[0-9][0-9,]*(?:\.[0-9]+)?\s*(?:조|억|천만|백만|만|천)\s*)*[0-9][0-9,]*(?:\.[0-9]+)?\s*(?:조|억|천만|백만|만|천)?\s*원`)
+	claimPartRe   = regexp.MustCompile(`([0-9][0-9,]*(?:\.[0-9]+)?)\s*(조|억|천만|백만|만|천)?`)
+)
+
+var koreanUnits = map[string]float64{
+	"": 1, "천": 1e3, "만": 1e4, "백만": 1e6, "천만": 1e7, "억": 1e8, "조": 1e12,
+}
+
+// ParseClaimAmount extracts the largest KRW amount stated in a claim text,
+// such as "금 12,345,678원", "1,500백만원" or "3억 5천만원". It returns 0
+// when no amount is found.
+func ParseClaimAmount(text string) int64 {
+	var best float64
+	for _, m := range claimAmountRe.FindAllString(text, -1) {
+		var v float64
+		for _, p := range claimPartRe.FindAllStringSubmatch(m, -1) {
+			n, err := strconv.ParseFloat(strings.ReplaceAll(p[1], ",", ""), 64)
+			if err != nil {
+				continue
+			}
+			v += n * koreanUnits[p[2]]
+		}
+		if v > best {
+			best = v
+		}
+	}
+	return int64(best)
+}
+
+// Lawsuits normalises filings and sorts them by materiality: largest claim
+// first, cases without a stated amount last, ties broken by newest filing.
+func Lawsuits(rows []api.LawsuitFiling, equity int64) []Lawsuit {
+	out := make([]Lawsuit, 0, len(rows))
+	for _, r := range rows {
+		l := Lawsuit{
+			RceptNo:     r.RceptNo,
+			FiledDate:   collapse(r.FiledDate),
+			CaseName:    collapse(r.CaseName),
+			Plaintiff:   collapse(r.Plaintiff),
+			Court:       collapse(r.Court),
+			Claim:       collapse(r.Claim),
+			Plan:        collapse(r.Plan),
+			ClaimAmount: ParseClaimAmount(r.Claim),
+		}
+		if equity > 0 && l.ClaimAmount > 0 {
+			l.EquityPct = float64(l.ClaimAmount) / float64(equity) * 100
+		}
+		out = append(out, l)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].ClaimAmount != out[j].ClaimAmount {
+			return out[i].ClaimAmount > out[j].ClaimAmount
+		}
+		return out[i].RceptNo > out[j].RceptNo
+	})
+	return out
+}
+
+// LitigationMarkdown renders lawsuits as a materiality-ordered table
+// followed by each case's claim and the company's response.
+func LitigationMarkdown(corpName, start, end string, equity Equity, suits []Lawsuit) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 소송 등의 제기\n\n", corpName)
+	fmt.Fprintf(&sb, "기간: %s ~ %s · 총 **%d**건\n\n", FormatDate(start), FormatDate(end), len(suits))
+	if equity.Amount > 0 {
+		fmt.Fprintf(&sb, "자본총계 %s원 (%s 기준)\n\n", FormatAmount(strconv.FormatInt(equity.Amount, 10)), equity.Source)
+	}
+
+	sb.WriteString("| # | 제기일 | 사건명 | 원고·신청인 | 청구금액 | 자본 대비 | 관할법원 |\n")
+	sb.WriteString("|--:|--------|--------|------------|--------:|---------:|----------|\n")
+	for i, l := range suits {
+		claim, ratio := "-", "-"
+		if l.ClaimAmount > 0 {
+			claim = FormatAmount(strconv.FormatInt(l.ClaimAmount, 10)) + "원"
+			if l.EquityPct > 0 {
+				ratio = fmt.Sprintf("%.2f%%", l.EquityPct)
+			}
+		}
+		fmt.Fprintf(&sb, "| %d | %s | %s | %s | %s | %s | %s |\n",
+			i+1, dash(l.FiledDate), escapePipes(dash(truncateRunes(l.CaseName, 40))),
+			escapePipes(dash(truncateRunes(l.Plaintiff, 30))), claim, ratio, escapePipes(dash(l.Court)))
+	}
+	sb.WriteString("\n")
+
+	for i, l := range suits {
+		fmt.Fprintf(&sb, "## %d. %s\n\n", i+1, dash(l.CaseName))
+		if l.Claim != "" {
+			fmt.Fprintf(&sb, "- **청구내용**: %s\n", truncateRunes(l.Claim, 300))
+		}
+		if l.Plan != "" {
+			fmt.Fprintf(&sb, "- **향후대책**: %s\n", truncateRunes(l.Plan, 300))
+		}
+		fmt.Fprintf(&sb, "- **원문**: [%s](%s)\n\n", l.RceptNo, DARTViewURL(l.RceptNo))
+	}
+	return sb.String()
+}
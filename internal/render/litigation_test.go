@@ -0,0 +1,37 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestParseClaimAmount(t *testing.T) {
+	cases := map[string]int64{
+		"금 12,345,678원 및 이에 대한 지연손해금": 12_345_678,
+		"손해배상금 1,500백만원":              1_500_000_000,
+		"3억 5천만원 및 지연이자 1,000,000원":   350_000_000,
+		"1.2조원 지급 청구":                 1_200_000_000_000,
+		"주주총회결의 무효 확인":                0,
+	}
+	for in, want := range cases {
+		if got := ParseClaimAmount(in); got != want {
+			t.Errorf("ParseClaimAmount(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestLawsuitsSortedByMateriality(t *testing.T) {
+	rows := []api.LawsuitFiling{
+		{ReportHeader: api.ReportHeader{RceptNo: "20240101000001"}, Claim: "금 10억원"},
+		{ReportHeader: api.ReportHeader{RceptNo: "20240301000002"}, Claim: "가처분 신청"},
+		{ReportHeader: api.ReportHeader{RceptNo: "20240201000003"}, Claim: "금 50억원"},
+	}
+	suits := Lawsuits(rows, 100_000_000_000)
+	if suits[0].RceptNo != "20240201000003" || suits[1].RceptNo != "20240101000001" || suits[2].ClaimAmount != 0 {
+		t.Fatalf("청구금액 내림차순 정렬 오류: %+v", suits)
+	}
+	if suits[0].EquityPct != 5 {
+		t.Errorf("자본 대비 비율 = %v, want 5", suits[0].EquityPct)
+	}
+}
@@ -0,0 +1,261 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// MnASources groups the raw decision rows behind an M&A timeline.
+type MnASources struct {
+	Mergers             []api.MergerDecision
+	Splits              []api.SplitDecision
+	SplitMergers        []api.SplitMergerDecision
+	ShareExchanges      []api.ShareExchangeDecision
+	BusinessAcquisition []api.BusinessTransferDecision
+	BusinessTransfer    []api.BusinessTransferDecision
+	AssetAcquisition    []api.AssetTransferDecision
+	AssetTransfer       []api.AssetTransferDecision
+	StockAcquisition    []api.StockTransferDecision
+	StockTransfer       []api.StockTransferDecision
+}
+
+// MnAEvent is one merger/restructuring decision normalised across endpoints.
+type MnAEvent struct {
+	Type          string `json:"type"`
+	RceptNo       string `json:"rcept_no"`
+	BoardDate     string `json:"board_date"`
+	Counterparty  string `json:"counterparty"`
+	Relation      string `json:"relation"`
+	Affiliate     bool   `json:"affiliate"`
+	Target        string `json:"target"` // issuer of the shares traded, stock deals only
+	Consideration string `json:"consideration"`
+	Ratio         string `json:"ratio"`
+	ScheduleLabel string `json:"schedule_label"`
+	Schedule      string `json:"schedule"`
+	Purpose       string `json:"purpose"`
+}
+
+// affiliateMarkers are relation phrases that indicate an intra-group deal.
+var affiliateMarkers = []string{"계열", "자회사", "종속", "모회사", "지배회사", "최대주주", "특수관계"}
+
+// IsAffiliate reports whether a 회사와의 관계 description names a related party.
+func IsAffiliate(relation string) bool {
+	r := strings.ReplaceAll(relation, " ", "")
+	if r == "" || r == "-" || strings.Contains(r, "해당사항없") || strings.Contains(r, "없음") {
+		return false
+	}
+	for _, m := range affiliateMarkers {
+		if strings.Contains(r, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// MnATimeline flattens every decision into one list, newest first.
+func MnATimeline(src MnASources) []MnAEvent {
+	var out []MnAEvent
+	add := func(e MnAEvent) {
+		e.Counterparty = collapse(e.Counterparty)
+		e.Relation = collapse(e.Relation)
+		e.Target = collapse(e.Target)
+		e.Purpose = collapse(e.Purpose)
+		if !e.Affiliate {
+			e.Affiliate = IsAffiliate(e.Relation)
+		}
+		out = append(out, e)
+	}
+
+	for _, r := range src.Mergers {
+		consideration := ""
+		if _, ok := ParseAmount(r.NewCommonShares); ok {
+			consideration = "합병신주 보통주 " + FormatAmountKRW(r.NewCommonShares) + "주"
+		}
+		if _, ok := ParseAmount(r.NewPrefShares); ok {
+			consideration = strings.TrimSpace(consideration + " / 종류주 " + FormatAmountKRW(r.NewPrefShares) + "주")
+		}
+		add(MnAEvent{
+			Type: "합병", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation,
+			Consideration: consideration, Ratio: r.Ratio,
+			ScheduleLabel: "합병기일", Schedule: r.EffectiveDate,
+			Purpose: firstNonEmpty(r.Purpose, r.Method),
+		})
+	}
+	// A spin-off's new company is carved out of the filer itself, so the deal
+	// is intra-group whatever the filing writes for the relation.
+	for _, r := range src.Splits {
+		add(MnAEvent{
+			Type: "분할", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.NewCompany, Relation: "분할신설회사", Affiliate: true,
+			Consideration: r.Business, Ratio: r.Ratio,
+			ScheduleLabel: "분할기일", Schedule: r.EffectiveDate,
+			Purpose: r.Method,
+		})
+	}
+	for _, r := range src.SplitMergers {
+		add(MnAEvent{
+			Type: "분할합병", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation,
+			Ratio:         r.Ratio,
+			ScheduleLabel: "분할합병기일", Schedule: r.EffectiveDate,
+			Purpose: r.Method,
+		})
+	}
+	for _, r := range src.ShareExchanges {
+		add(MnAEvent{
+			Type: "주식교환·이전", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation,
+			Ratio:         r.Ratio,
+			ScheduleLabel: "교환·이전일", Schedule: r.EffectiveDate,
+			Purpose: firstNonEmpty(r.Purpose, r.Form, r.Kind),
+		})
+	}
+	for _, r := range src.BusinessAcquisition {
+		add(MnAEvent{
+			Type: "영업양수", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation,
+			Consideration: wonAmount(r.InhPrice),
+			ScheduleLabel: "양수기준일", Schedule: r.InhDate,
+			Purpose: firstNonEmpty(r.InhPurpose, r.InhBusiness),
+		})
+	}
+	for _, r := range src.BusinessTransfer {
+		add(MnAEvent{
+			Type: "영업양도", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation,
+			Consideration: wonAmount(r.TrfPrice),
+			ScheduleLabel: "양도기준일", Schedule: r.TrfDate,
+			Purpose: firstNonEmpty(r.TrfPurpose, r.TrfBusiness),
+		})
+	}
+	for _, r := range src.AssetAcquisition {
+		add(MnAEvent{
+			Type: "유형자산 양수", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation,
+			Consideration: wonAmount(r.InhPrice),
+			ScheduleLabel: "등기예정일", Schedule: r.InhDate,
+			Purpose: firstNonEmpty(r.InhPurpose, r.AssetName, r.AssetKind),
+		})
+	}
+	for _, r := range src.AssetTransfer {
+		add(MnAEvent{
+			Type: "유형자산 양도", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation,
+			Consideration: wonAmount(r.TrfPrice),
+			ScheduleLabel: "등기예정일", Schedule: r.TrfDate,
+			Purpose: firstNonEmpty(r.TrfPurpose, r.AssetName, r.AssetKind),
+		})
+	}
+	for _, r := range src.StockAcquisition {
+		add(MnAEvent{
+			Type: "타법인주식 양수", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation, Target: r.Issuer,
+			Consideration: joinNonEmpty(wonAmount(r.InhPrice), sharesLabel(r.InhShares)),
+			ScheduleLabel: "양수예정일", Schedule: r.InhDate,
+			Purpose: r.InhPurpose,
+		})
+	}
+	for _, r := range src.StockTransfer {
+		add(MnAEvent{
+			Type: "타법인주식 양도", RceptNo: r.RceptNo, BoardDate: r.BoardDate,
+			Counterparty: r.Counterparty, Relation: r.Relation, Target: r.Issuer,
+			Consideration: joinNonEmpty(wonAmount(r.TrfPrice), sharesLabel(r.TrfShares)),
+			ScheduleLabel: "양도예정일", Schedule: r.TrfDate,
+			Purpose: r.TrfPurpose,
+		})
+	}
+
+	sort.SliceStable(out, func(i, j int) bool {
+		di, dj := digitsOnly(out[i].BoardDate), digitsOnly(out[j].BoardDate)
+		if di != dj {
+			return di > dj
+		}
+		return out[i].RceptNo > out[j].RceptNo
+	})
+	return out
+}
+
+// MnAMarkdown renders the timeline table followed by per-event purposes.
+func MnAMarkdown(corpName, startDate, endDate string, events []MnAEvent) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 합병·분할·양수도 결정\n\n", corpName)
+	affiliates := 0
+	for _, e := range events {
+		if e.Affiliate {
+			affiliates++
+		}
+	}
+	fmt.Fprintf(&sb, "%s ~ %s · 총 **%d**건 · 계열사 간 거래 **%d**건\n\n",
+		FormatDate(startDate), FormatDate(endDate), len(events), affiliates)
+
+	sb.WriteString("| 이사회결의일 | 구분 | 상대방 | 계열 | 대가 | 비율 | 일정 | 접수번호 |\n")
+	sb.WriteString("|--------------|------|--------|------|------|------|------|----------|\n")
+	for _, e := range events {
+		affiliate := "-"
+		if e.Affiliate {
+			affiliate = "✓"
+		}
+		counterparty := dash(e.Counterparty)
+		if e.Relation != "" && e.Relation != "-" {
+			counterparty += " (" + e.Relation + ")"
+		}
+		schedule := "-"
+		if strings.TrimSpace(e.Schedule) != "" && e.Schedule != "-" {
+			schedule = e.ScheduleLabel + " " + e.Schedule
+		}
+		consideration := e.Consideration
+		if e.Target != "" && e.Target != "-" {
+			consideration = joinNonEmpty(e.Target+" 주식", consideration)
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %s | `%s` |\n",
+			dash(e.BoardDate), e.Type, counterparty, affiliate,
+			dash(consideration), dash(collapse(e.Ratio)), schedule, e.RceptNo)
+	}
+	sb.WriteString("\n")
+
+	wrote := false
+	for _, e := range events {
+		if e.Purpose == "" || e.Purpose == "-" {
+			continue
+		}
+		if !wrote {
+			sb.WriteString("## 목적 및 내용\n\n")
+			wrote = true
+		}
+		fmt.Fprintf(&sb, "- **%s %s** (%s): %s\n", dash(e.BoardDate), e.Type, dash(e.Counterparty), e.Purpose)
+	}
+	if wrote {
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// wonAmount formats a raw KRW amount in 억 units, or "" when absent.
+func wonAmount(s string) string {
+	if _, ok := ParseAmount(s); !ok {
+		return ""
+	}
+	return FormatAmount(s) + "원"
+}
+
+func sharesLabel(s string) string {
+	if _, ok := ParseAmount(s); !ok {
+		return ""
+	}
+	return FormatAmountKRW(s) + "주"
+}
+
+func joinNonEmpty(vals ...string) string {
+	var parts []string
+	for _, v := range vals {
+		if v != "" {
+			parts = append(parts, v)
+		}
+	}
+	return strings.Join(parts, " / ")
+}
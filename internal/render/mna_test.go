@@ -0,0 +1,65 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestIsAffiliate(t *testing.T) {
+	cases := map[string]bool{
+		"계열회사":        true,
+		"종속회사":        true,
+		"최대주주의 특수관계인": true,
+		"-":           false,
+		"해당사항 없음":     false,
+		"거래처":         false,
+	}
+	for rel, want := range cases {
+		if got := IsAffiliate(rel); got != want {
+			t.Errorf("IsAffiliate(%q) = %v, want %v", rel, got, want)
+		}
+	}
+}
+
+func TestMnATimeline_최신순_정규화(t *testing.T) {
+	src := MnASources{
+		Mergers: []api.MergerDecision{
+			{ReportHeader: api.ReportHeader{RceptNo: "A"}, BoardDate: "2023년 05월 02일",
+				Counterparty: "에이회사", Relation: "계열회사", Ratio: "1 : 0.5", NewCommonShares: "1,000"},
+		},
+		StockAcquisition: []api.StockTransferDecision{
+			{ReportHeader: api.ReportHeader{RceptNo: "B"}, BoardDate: "2024년 01월 10일",
+				Issuer: "비회사", IssuerRelation: "-", Counterparty: "씨회사", Relation: "최대주주", InhPrice: "50000000000"},
+		},
+		StockTransfer: []api.StockTransferDecision{
+			{ReportHeader: api.ReportHeader{RceptNo: "C"}, BoardDate: "2022년 03월 02일",
+				Issuer: "디회사", IssuerRelation: "계열회사", Counterparty: "이회사", Relation: "해당사항 없음", TrfShares: "300"},
+		},
+	}
+	events := MnATimeline(src)
+	if len(events) != 3 || events[0].RceptNo != "B" {
+		t.Fatalf("최신순 3건 기대, got %+v", events)
+	}
+	if events[0].Counterparty != "씨회사" || events[0].Relation != "최대주주" || events[0].Target != "비회사" {
+		t.Errorf("상대방은 거래상대방, 대상은 발행회사여야 함: %+v", events[0])
+	}
+	if !events[0].Affiliate {
+		t.Error("거래상대방이 최대주주면 계열 거래로 표시해야 함")
+	}
+	if events[0].Consideration != "500.0억원" {
+		t.Errorf("양수금액 표시: got %q", events[0].Consideration)
+	}
+	if events[1].Consideration != "합병신주 보통주 1,000주" || !events[1].Affiliate {
+		t.Errorf("합병 정규화 오류: %+v", events[1])
+	}
+	if events[2].Counterparty != "이회사" || events[2].Affiliate {
+		t.Errorf("계열 여부는 발행회사가 아닌 거래상대방 관계로 판단해야 함: %+v", events[2])
+	}
+
+	md := MnAMarkdown("가나다", "20220101", "20241231", events)
+	if !strings.Contains(md, "| 씨회사 (최대주주) | ✓ | 비회사 주식 / 500.0억원 |") {
+		t.Errorf("타법인주식 거래 행 표시 오류:\n%s", md)
+	}
+}
@@ -0,0 +1,281 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// Offering is one 증권신고서 with its groups narrowed to that filing.
+type Offering struct {
+	Kind    string                  `json:"kind"`
+	RceptNo string                  `json:"rcept_no"`
+	Groups  []api.RegistrationGroup `json:"groups"`
+}
+
+// registrationLabels are the Korean labels for 증권신고서 주요정보 fields.
+var registrationLabels = map[string]string{
+	"sbd": "청약기일", "pymd": "납입기일", "sband": "청약공고일", "asand": "배정공고일",
+	"asstd": "배정기준일", "rpd": "상환기일", "exstk": "행사대상증권", "exprc": "행사가격",
+	"expd": "행사기간", "rpt_rcpn": "주요사항보고서 접수번호", "stksen": "증권종류",
+	"stkcnt": "증권수량", "fv": "액면가액", "slprc": "모집(매출)가액", "slta": "모집(매출)총액",
+	"slmthn": "모집(매출)방법", "slmth": "모집(매출)방법", "actsen": "인수인구분",
+	"actnmn": "인수인명", "udtcnt": "인수수량", "udtamt": "인수금액", "udtprc": "인수대가",
+	"udtmth": "인수방법", "se": "구분", "amt": "금액", "hdr": "보유자", "rl_cmp": "회사와의 관계",
+	"bfsl_hdstk": "매출전 보유증권수", "slstk": "매출증권수", "atsl_hdstk": "매출후 보유증권수",
+	"grtrs": "부여사유", "exavivr": "행사가능 투자자", "grtcnt": "부여수량",
+	"bdnmn": "채무증권 명칭", "fta": "권면총액", "isprc": "발행가액", "intr": "이자율",
+	"isrr": "발행수익률", "print_pymint": "원리금지급대행기관", "mngt_cmp": "관리회사",
+	"cdrt_int": "신용등급", "dpcrtnm": "예탁증권 명칭", "udstk": "원주",
+	"cmpnm": "회사명", "sen": "구분", "tast": "총자산", "cpt": "자본금", "isstk_knd": "발행주식 종류",
+	"isstk_cnt": "발행주식수", "stn": "형태", "bddd": "이사회결의일", "ctrd": "계약일",
+	"gmtsck_shddstd": "주주확정기준일", "ap_gmtsck": "주주총회 예정일",
+	"aprskh_pd": "주식매수청구권 행사기간", "aprskh_prc": "매수예정가격",
+	"endsd": "구주권제출 마감일", "mgdt": "합병기일", "dvdt": "분할기일",
+	"extrdt": "교환·이전일", "nstk_dlprd": "신주권교부예정일", "nstk_lstprd": "신주상장예정일",
+}
+
+// registrationHeaderKeys are repeated on every row and shown once per filing.
+var registrationHeaderKeys = map[string]bool{
+	"rcept_no": true, "corp_cls": true, "corp_code": true, "corp_name": true,
+}
+
+// registrationScheduleKeys are pulled out of 일반사항 into the schedule table.
+var registrationScheduleKeys = []string{
+	"sband", "asstd", "asand", "sbd", "pymd", "rpd", "bddd", "ctrd",
+	"gmtsck_shddstd", "ap_gmtsck", "aprskh_pd", "endsd", "mgdt", "dvdt", "extrdt",
+	"nstk_dlprd", "nstk_lstprd",
+}
+
+// registrationAmountKeys hold KRW amounts or share counts.
+var registrationAmountKeys = map[string]bool{
+	"stkcnt": true, "fv": true, "slprc": true, "slta": true, "udtcnt": true,
+	"udtamt": true, "udtprc": true, "amt": true, "bfsl_hdstk": true, "slstk": true,
+	"atsl_hdstk": true, "grtcnt": true, "fta": true, "isprc": true, "exprc": true,
+	"tast": true, "cpt": true, "isstk_cnt": true, "aprskh_prc": true,
+}
+
+// SplitOfferings separates a registration response, which may cover several
+// filings, into one Offering per 접수번호 in order of appearance.
+func SplitOfferings(kind string, groups []api.RegistrationGroup) []Offering {
+	var order []string
+	seen := map[string]bool{}
+	for _, g := range groups {
+		for _, it := range g.Items {
+			if no := it.Get("rcept_no"); no != "" && !seen[no] {
+				seen[no] = true
+				order = append(order, no)
+			}
+		}
+	}
+
+	out := make([]Offering, 0, len(order))
+	for _, no := range order {
+		o := Offering{Kind: kind, RceptNo: no}
+		for _, g := range groups {
+			var items []api.Fields
+			for _, it := range g.Items {
+				if it.Get("rcept_no") == no {
+					items = append(items, it)
+				}
+			}
+			if len(items) > 0 {
+				o.Groups = append(o.Groups, api.RegistrationGroup{Title: g.Title, Items: items})
+			}
+		}
+		out = append(out, o)
+	}
+	return out
+}
+
+// OfferingMarkdown renders each filing's schedule, offering structure,
+// underwriters and fees, use of proceeds and remaining groups.
+func OfferingMarkdown(corpName string, offerings []Offering) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "# %s 증권신고서 주요정보\n\n", corpName)
+	fmt.Fprintf(&sb, "총 **%d**건\n\n", len(offerings))
+
+	for _, o := range offerings {
+		filed := ""
+		if len(o.RceptNo) >= 8 {
+			filed = FormatDate(o.RceptNo[:8])
+		}
+		fmt.Fprintf(&sb, "## %s 증권신고서 (%s)\n\n", o.Kind, filed)
+		fmt.Fprintf(&sb, "원문: [%s](%s)\n\n", o.RceptNo, DARTViewURL(o.RceptNo))
+
+		offerTotal := offeringTotal(o)
+		for _, g := range o.Groups {
+			title := strings.ReplaceAll(g.Title, " ", "")
+			switch {
+			case strings.Contains(title, "일반사항"):
+				writeGeneral(&sb, g)
+			case strings.Contains(title, "자금"):
+				writeGroupTable(&sb, g, "amt")
+			default:
+				writeGroupTable(&sb, g, "")
+			}
+			if strings.Contains(title, "인수인") {
+				writeUnderwritingFee(&sb, g, offerTotal)
+			}
+		}
+	}
+	return sb.String()
+}
+
+// writeGeneral renders 일반사항 as a schedule table and a key/value table.
+func writeGeneral(sb *strings.Builder, g api.RegistrationGroup) {
+	for _, it := range g.Items {
+		var schedule [][2]string
+		isSchedule := map[string]bool{}
+		for _, k := range registrationScheduleKeys {
+			isSchedule[k] = true
+			if v := strings.TrimSpace(it.Get(k)); v != "" && v != "-" {
+				schedule = append(schedule, [2]string{registrationLabel(k), v})
+			}
+		}
+		if len(schedule) > 0 {
+			sb.WriteString("### 일정\n\n| 항목 | 일자 |\n|------|------|\n")
+			for _, kv := range schedule {
+				fmt.Fprintf(sb, "| %s | %s |\n", kv[0], kv[1])
+			}
+			sb.WriteString("\n")
+		}
+
+		var rows [][2]string
+		for _, kv := range it {
+			if registrationHeaderKeys[kv.Key] || isSchedule[kv.Key] {
+				continue
+			}
+			if v := strings.TrimSpace(kv.Value); v != "" && v != "-" {
+				rows = append(rows, [2]string{registrationLabel(kv.Key), registrationValue(kv.Key, v)})
+			}
+		}
+		if len(rows) > 0 {
+			fmt.Fprintf(sb, "### %s\n\n| 항목 | 내용 |\n|------|------|\n", g.Title)
+			for _, kv := range rows {
+				fmt.Fprintf(sb, "| %s | %s |\n", kv[0], escapePipes(kv[1]))
+			}
+			sb.WriteString("\n")
+		}
+	}
+}
+
+// writeGroupTable renders a multi-row group. When shareKey is set, a 비중
+// column shows each row's share of that amount column's total.
+func writeGroupTable(sb *strings.Builder, g api.RegistrationGroup, shareKey string) {
+	var cols []string
+	seen := map[string]bool{}
+	for _, it := range g.Items {
+		for _, kv := range it {
+			if registrationHeaderKeys[kv.Key] || seen[kv.Key] {
+				continue
+			}
+			seen[kv.Key] = true
+			cols = append(cols, kv.Key)
+		}
+	}
+	if len(cols) == 0 {
+		return
+	}
+
+	var total int64
+	if shareKey != "" {
+		for _, it := range g.Items {
+			if v, ok := ParseAmount(it.Get(shareKey)); ok {
+				total += v
+			}
+		}
+	}
+
+	fmt.Fprintf(sb, "### %s\n\n|", g.Title)
+	for _, c := range cols {
+		fmt.Fprintf(sb, " %s |", registrationLabel(c))
+	}
+	if total > 0 {
+		sb.WriteString(" 비중 |")
+	}
+	sb.WriteString("\n|")
+	for _, c := range cols {
+		if registrationAmountKeys[c] {
+			sb.WriteString("---:|")
+		} else {
+			sb.WriteString("---|")
+		}
+	}
+	if total > 0 {
+		sb.WriteString("---:|")
+	}
+	sb.WriteString("\n")
+
+	for _, it := range g.Items {
+		sb.WriteString("|")
+		for _, c := range cols {
+			fmt.Fprintf(sb, " %s |", escapePipes(dash(registrationValue(c, it.Get(c)))))
+		}
+		if total > 0 {
+			v, _ := ParseAmount(it.Get(shareKey))
+			fmt.Fprintf(sb, " %.1f%% |", float64(v)/float64(total)*100)
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+}
+
+// writeUnderwritingFee summarises 인수대가 against the offering size.
+func writeUnderwritingFee(sb *strings.Builder, g api.RegistrationGroup, offerTotal int64) {
+	var fee int64
+	for _, it := range g.Items {
+		if v, ok := ParseAmount(it.Get("udtprc")); ok {
+			fee += v
+		}
+	}
+	if fee == 0 {
+		return
+	}
+	fmt.Fprintf(sb, "인수수수료 합계 **%s원**", commaInt(fee))
+	if offerTotal > 0 {
+		fmt.Fprintf(sb, " (모집총액 대비 %.2f%%)", float64(fee)/float64(offerTotal)*100)
+	}
+	sb.WriteString("\n\n")
+}
+
+// offeringTotal returns 모집(매출)총액, summed over 증권의종류 rows when the
+// 일반사항 group does not state it.
+func offeringTotal(o Offering) int64 {
+	var total int64
+	for _, g := range o.Groups {
+		for _, it := range g.Items {
+			v, ok := ParseAmount(it.Get("slta"))
+			if !ok {
+				continue
+			}
+			if strings.Contains(strings.ReplaceAll(g.Title, " ", ""), "일반사항") {
+				return v
+			}
+			total += v
+		}
+	}
+	return total
+}
+
+func registrationLabel(key string) string {
+	if l, ok := registrationLabels[key]; ok {
+		return l
+	}
+	return key
+}
+
+func registrationValue(key, val string) string {
+	if registrationAmountKeys[key] {
+		if _, ok := ParseAmount(val); ok {
+			return FormatAmountKRW(val)
+		}
+	}
+	return collapse(val)
+}
+
+func escapePipes(s string) string {
+	return strings.ReplaceAll(s, "|", "｜")
+}
@@ -0,0 +1,42 @@
+package render
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestOfferingMarkdown(t *testing.T) {
+	raw := `[
+	 {"title":"일반사항","list":[
+	  {"rcept_no":"20240115000123","corp_name":"테스트","sbd":"2024년 02월 01일","pymd":"2024년 02월 05일","slta":"10,000,000,000"},
+	  {"rcept_no":"20230601000999","corp_name":"테스트","sbd":"2023년 07월 01일"}]},
+	 {"title":"인수인정보","list":[
+	  {"rcept_no":"20240115000123","actnmn":"가증권","udtamt":"6,000,000,000","udtprc":"150,000,000"},
+	  {"rcept_no":"20240115000123","actnmn":"나증권","udtamt":"4,000,000,000","udtprc":"100,000,000"}]},
+	 {"title":"자금의사용목적","list":[
+	  {"rcept_no":"20240115000123","se":"시설자금","amt":"7,500,000,000"},
+	  {"rcept_no":"20240115000123","se":"운영자금","amt":"2,500,000,000"}]}
+	]`
+	var groups []api.RegistrationGroup
+	if err := json.Unmarshal([]byte(raw), &groups); err != nil {
+		t.Fatal(err)
+	}
+
+	offerings := SplitOfferings("지분증권", groups)
+	if len(offerings) != 2 || offerings[0].RceptNo != "20240115000123" || len(offerings[0].Groups) != 3 {
+		t.Fatalf("접수번호별 분리 오류: %+v", offerings)
+	}
+	if len(offerings[1].Groups) != 1 {
+		t.Errorf("두 번째 신고서는 일반사항만 있어야 함: %+v", offerings[1].Groups)
+	}
+
+	md := OfferingMarkdown("테스트", offerings[:1])
+	for _, want := range []string{"| 청약기일 | 2024년 02월 01일 |", "인수수수료 합계 **250,000,000원**", "(모집총액 대비 2.50%)", "| 75.0% |"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("출력에 %q 없음:\n%s", want, md)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+// ProceedsSource is one annual report's 공모/사모자금 사용내역.
+type ProceedsSource struct {
+	Year    int
+	Public  []api.PublicFundUsage
+	Private []api.PrivateFundUsage
+}
+
+// FundingRound lines up one financing round's planned purposes against
+// the actual use reported in the most recent annual report covering it.
+type FundingRound struct {
+	Offering   string    `json:"offering"` // 공모 or 사모
+	Kind       string    `json:"kind"`     // 유상증자, 전환사채 …
+	Round      string    `json:"round"`
+	PaidDate   string    `json:"paid_date"`
+	PaidAmount int64     `json:"paid_amount"`
+	ReportYear int       `json:"report_year"`
+	RceptNo    string    `json:"rcept_no"`
+	Planned    []FundUse `json:"planned"`
+	Actual     []FundUse `json:"actual"`
+	Reasons    []string  `json:"reasons"`
+	Diverted   []string  `json:"diverted"` // "시설자금 → 운영자금"
+}
+
+// FacilityToOperation reports whether money planned for 시설자금 was used
+// as 운영자금, the pattern governance reviews look for.
+func (r FundingRound) FacilityToOperation() bool {
+	for _, d := range r.Diverted {
+		if d == "시설자금 → 운영자금" {
+			return true
+		}
+	}
+	return false
+}
+
+// fundCategories are the purpose buckets shared with 증자 결정 fdpp_* fields.
+var fundCategories = []string{"시설자금", "영업양수자금", "운영자금", "채무상환자금", "타법인증권 취득자금", "기타자금"}
+
+// FundCategory maps a free-text purpose to one of the standard buckets.
+func FundCategory(purpose string) string {
+	p := strings.ReplaceAll(purpose, " ", "")
+	switch {
+	case p == "":
+		return ""
+	case strings.Contains(p, "시설"):
+		return "시설자금"
+	case strings.Contains(p, "영업양수"):
+		return "영업양수자금"
+	case strings.Contains(p, "운영"), strings.Contains(p, "운전"):
+		return "운영자금"
+	case strings.Contains(p, "채무"), strings.Contains(p, "상환"):
+		return "채무상환자금"
+	case strings.Contains(p, "타법인"), strings.Contains(p, "지분"), strings.Contains(p, "증권취득"):
+		return "타법인증권 취득자금"
+	default:
+		return "기타자금"
+	}
+}
+
+type roundRow struct {
+	offering                string
+	usage                   api.FundUsage
+	planPurpose, planAmount string
+}
+
+// FundingRounds groups usage rows into rounds. Sources may overlap; for
+// each round the newest report wins. Rounds are returned newest first.
+func FundingRounds(sources []ProceedsSource) []FundingRound {
+	sorted := append([]ProceedsSource(nil), sources...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Year > sorted[j].Year })
+
+	taken := map[string]bool{}
+	var out []FundingRound
+	for _, src := range sorted {
+		var rows []roundRow
+		for _, u := range src.Public {
+			rows = append(rows, roundRow{"공모", u.FundUsage, u.PlanPurpose, u.PlanAmount})
+		}
+		for _, u := range src.Private {
+			rows = append(rows, roundRow{"사모", u.FundUsage, u.PlanPurpose, u.PlanAmount})
+		}
+
+		grouped := map[string][]roundRow{}
+		var order []string
+		for _, r := range rows {
+			u := r.usage
+			key := strings.Join([]string{r.offering, collapse(u.Kind), collapse(u.Round), collapse(u.PaidDate)}, "|")
+			if taken[key] {
+				continue
+			}
+			if _, ok := grouped[key]; !ok {
+				order = append(order, key)
+			}
+			grouped[key] = append(grouped[key], r)
+		}
+		for _, key := range order {
+			taken[key] = true
+			out = append(out, fundingRound(key, src.Year, grouped[key]))
+		}
+	}
+
+	sort.SliceStable(out, func(i, j int) bool { return digitsOnly(out[i].PaidDate) > digitsOnly(out[j].PaidDate) })
+	return out
+}
+
+func fundingRound(key string, year int, rows []roundRow) FundingRound {
+	parts := strings.SplitN(key, "|", 4)
+	r := FundingRound{Offering: parts[0], Kind: parts[1], Round: parts[2], PaidDate: parts[3], ReportYear: year}
+
+	planned := map[string]int64{}
+	actual := map[string]int64{}
+	seenReason := map[string]bool{}
+	for _, row := range rows {
+		u := row.usage
+		if r.RceptNo == "" {
+			r.RceptNo = u.RceptNo
+		}
+		if v, ok := ParseAmount(u.PaidAmount); ok && v > r.PaidAmount {
+			r.PaidAmount = v
+		}
+		if c := FundCategory(row.planPurpose); c != "" {
+			v, _ := ParseAmount(row.planAmount)
+			planned[c] += v
+		}
+		if c := FundCategory(u.ActualPurpose); c != "" {
+			v, _ := ParseAmount(u.ActualAmount)
+			actual[c] += v
+		}
+		if reason := collapse(u.DiffReason); reason != "" && reason != "-" && !seenReason[reason] {
+			seenReason[reason] = true
+			r.Reasons = append(r.Reasons, reason)
+		}
+	}
+	r.Planned = categoryUses(planned)
+	r.Actual = categoryUses(actual)
+	r.Diverted = divertedFunds(planned, actual)
+	return r
+}
+
+func categoryUses(m map[string]int64) []FundUse {
+	var out []FundUse
+	for _, c := range fundCategories {
+		if v, ok := m[c]; ok {
+			out = append(out, FundUse{Purpose: c, Amount: v})
+		}
+	}
+	return out
+}
+
+// divertedFunds pairs purposes that were under-spent against plan with
+// purposes that were over-spent or unplanned. Differences under 5% of the
+// planned total are treated as noise.
+func divertedFunds(planned, actual map[string]int64) []string {
+	var planTotal, actualTotal int64
+	for _, v := range planned {
+		planTotal += v
+	}
+	for _, v := range actual {
+		actualTotal += v
+	}
+	if planTotal == 0 || actualTotal == 0 {
+		return nil
+	}
+	threshold := planTotal / 20
+
+	var short, excess []string
+	for _, c := range fundCategories {
+		diff := actual[c] - planned[c]
+		switch {
+		case planned[c] > 0 && -diff > threshold:
+			short = append(short, c)
+		case diff > threshold:
+			excess = append(excess, c)
+		}
+	}
+	var out []string
+	for _, s := range short {
+		for _, e := range excess {
+			out = append(out, s+" → "+e)
+		}
+	}
+	return out
+}
+
+// ProceedsMarkdown renders a summary of all rounds followed by a
+// planned-versus-actual breakdown for each round.
+func ProceedsMarkdown(corpName string, years int, rounds []FundingRound) string {
+	var sb strings.Builder
+
+	diverted := 0
+	for _, r := range rounds {
+		if len(r.Diverted) > 0 {
+			diverted++
+		}
+	}
+
+	fmt.Fprintf(&sb, "# %s 공모·사모자금 사용내역\n\n", corpName)
+	fmt.Fprintf(&sb, "최근 %d개 사업보고서 · 총 **%d**회차 · 용도 전용 의심 **%d**회차\n\n", years, len(rounds), diverted)
+	sb.WriteString("금액 단위는 사업보고서 기재 단위를 따릅니다.\n\n")
+
+	sb.WriteString("| 구분 | 증권 | 회차 | 납입일 | 납입금액 | 계획 | 실제 사용 | 용도 전용 |\n")
+	sb.WriteString("|------|------|------|--------|--------:|------|----------|-----------|\n")
+	for _, r := range rounds {
+		div := "-"
+		if len(r.Diverted) > 0 {
+			div = strings.Join(r.Diverted, ", ")
+			if r.FacilityToOperation() {
+				div = "⚠ " + div
+			}
+		}
+		fmt.Fprintf(&sb, "| %s | %s | %s | %s | %s | %s | %s | %s |\n",
+			r.Offering, dash(r.Kind), dash(r.Round), dash(r.PaidDate), amountCell(r.PaidAmount),
+			usesSummary(r.Planned), usesSummary(r.Actual), div)
+	}
+	sb.WriteString("\n")
+
+	for _, r := range rounds {
+		fmt.Fprintf(&sb, "## %s %s %s회차 (%s)\n\n", r.Offering, dash(r.Kind), dash(r.Round), dash(r.PaidDate))
+		if len(r.Planned) == 0 && len(r.Actual) == 0 {
+			sb.WriteString("용도별 내역이 기재되지 않았습니다.\n\n")
+		} else {
+			sb.WriteString("| 용도 | 계획 | 실제 | 차이 |\n|------|-----:|-----:|-----:|\n")
+			plan := map[string]int64{}
+			act := map[string]int64{}
+			for _, u := range r.Planned {
+				plan[u.Purpose] = u.Amount
+			}
+			for _, u := range r.Actual {
+				act[u.Purpose] = u.Amount
+			}
+			for _, c := range fundCategories {
+				p, okP := plan[c]
+				a, okA := act[c]
+				if !okP && !okA {
+					continue
+				}
+				fmt.Fprintf(&sb, "| %s | %s | %s | %s |\n", c, amountCell(p), amountCell(a), signedInt(a-p))
+			}
+			sb.WriteString("\n")
+		}
+		if r.FacilityToOperation() {
+			sb.WriteString("> ⚠ 시설자금으로 조달한 자금이 운영자금으로 사용되었습니다.\n\n")
+		}
+		for _, reason := range r.Reasons {
+			fmt.Fprintf(&sb, "- **차이발생 사유**: %s\n", truncateRunes(reason, 300))
+		}
+		fmt.Fprintf(&sb, "- **출처**: %d년 사업보고서 [%s](%s)\n\n", r.ReportYear, r.RceptNo, DARTViewURL(r.RceptNo))
+	}
+	return sb.String()
+}
+
+func usesSummary(uses []FundUse) string {
+	if len(uses) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(uses))
+	for i, u := range uses {
+		parts[i] = u.Purpose + " " + commaInt(u.Amount)
+	}
+	return strings.Join(parts, " · ")
+}
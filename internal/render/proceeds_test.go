@@ -0,0 +1,36 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestFundingRoundsDetectsDiversion(t *testing.T) {
+	usage := func(rcept, actual, amount string) api.FundUsage {
+		return api.FundUsage{
+			ReportHeader: api.ReportHeader{RceptNo: rcept},
+			Kind:         "유상증자(제3자배정)", Round: "5", PaidDate: "2023.04.10", PaidAmount: "10,000",
+			ActualPurpose: actual, ActualAmount: amount,
+		}
+	}
+	newer := ProceedsSource{Year: 2024, Private: []api.PrivateFundUsage{
+		{FundUsage: usage("20250320000001", "운영자금(원재료 매입)", "7,000"), PlanPurpose: "시설자금", PlanAmount: "8,000"},
+		{FundUsage: usage("20250320000001", "시설투자", "3,000"), PlanPurpose: "운영자금", PlanAmount: "2,000"},
+	}}
+	older := ProceedsSource{Year: 2023, Private: []api.PrivateFundUsage{
+		{FundUsage: usage("20240320000001", "시설자금", "8,000"), PlanPurpose: "시설자금", PlanAmount: "8,000"},
+	}}
+
+	rounds := FundingRounds([]ProceedsSource{older, newer})
+	if len(rounds) != 1 || rounds[0].ReportYear != 2024 {
+		t.Fatalf("최신 보고서 기준 1회차 기대, got %+v", rounds)
+	}
+	r := rounds[0]
+	if len(r.Planned) != 2 || r.Planned[0] != (FundUse{"시설자금", 8000}) {
+		t.Errorf("계획 집계 오류: %+v", r.Planned)
+	}
+	if !r.FacilityToOperation() {
+		t.Errorf("시설자금 → 운영자금 전용 미검출: %+v", r.Diverted)
+	}
+}
@@ -0,0 +1,106 @@
+package render
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestParseRecovery(t *testing.T) {
+	const doc = `<DOCUMENT><DOCUMENT-NAME>사업보고서</DOCUMENT-NAME><BODY>
+<SECTION-1><TITLE ATOC="Y">I. 회사의 개요</TITLE>
+<P>AT&T < 삼성 & LG</P>
+<P>제어문자 ` + "\x01" + ` 이후는 버려집니다</P>
+<P>이 단락은 살아남습니다</P>
+</SECTION-1>
+<SECTION-1><TITLE ATOC="Y">II. 사업의 내용</TITLE>
+<TABLE><TBODY><TR><TD>부문</TD><TD>매출</TD></TR>
+<TR><TD>DX ` + "\xff" + `</TD><TD>1</TD></TR>
+<TR><TD>DS</TD><TD>2</TD></TR></TBODY></TABLE>
+<P>끝</P>
+</SECTION-1></BODY></DOCUMENT>`
+
+	d := ParseDocument([]byte(doc))
+	if len(d.Sections) != 2 {
+		t.Fatalf("섹션 %d개, 2개를 기대했습니다:\n%s", len(d.Sections), d.Markdown())
+	}
+	md := d.Markdown()
+	for _, want := range []string{"AT&T < 삼성 & LG", "이 단락은 살아남습니다", "| DS | 2 |", "끝"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("복구 후 %q 가 없습니다:\n%s", want, md)
+		}
+	}
+
+	if len(d.Warnings) != 2 {
+		t.Fatalf("복구 %d건, 2건을 기대했습니다: %+v", len(d.Warnings), d.Warnings)
+	}
+	for i, bad := range []string{"\x01", "\xff"} {
+		at := int64(strings.Index(doc, bad))
+		if w := d.Warnings[i]; w.Offset < at || w.Offset > at+4 {
+			t.Errorf("경고 %d 오프셋 = %d, 원본 %d 근처를 기대했습니다 (%s)", i, w.Offset, at, w.Message)
+		}
+	}
+}
+
+// fuzzSeeds are the sample documents plus fragments that used to stop
+// the decoder.
+func fuzzSeeds(f *testing.F) {
+	f.Add([]byte(sampleDARTXML))
+	files, _ := filepath.Glob(filepath.Join("testdata", "tables", "*.xml"))
+	for _, name := range files {
+		if data, err := os.ReadFile(name); err == nil {
+			f.Add(data)
+		}
+	}
+	for _, s := range []string{
+		"<P>x</Q><P>y</P>",
+		"<SECTION-1><TITLE>a</TITLE><P>\x00</P></SECTION-1></SECTION-2><P>z</P>",
+		"<TABLE><TR><TD COLSPAN=\"9999\" ROWSPAN=\"-1\">a</TD></TR></TABLE>",
+		"<!- x --><P>&#xZZ; &bogus; <이사ㆍ감사 보수현황></P>",
+		"<TABLE><TR><TD>(단위 : 백만원)</TD></TR><TR><TD>1</TD><TD>2</TD></TR>",
+		"<",
+		"",
+	} {
+		f.Add([]byte(s))
+	}
+}
+
+func FuzzSanitizeXML(f *testing.F) {
+	fuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		out := sanitizeXML(data)
+		if !bytes.Equal(sanitizeXML(out), out) {
+			t.Errorf("sanitizeXML 를 두 번 적용한 결과가 다릅니다: %q", data)
+		}
+		for i := range out {
+			if bareLT(out, i) {
+				t.Fatalf("위치 %d에 태그가 아닌 '<' 가 남았습니다: %q", i, out)
+			}
+		}
+		ws := []ParseWarning{{Offset: 0}, {Offset: int64(len(out) / 2)}, {Offset: int64(len(out))}}
+		originalOffsets(data, ws)
+		if ws[0].Offset != 0 || ws[2].Offset != int64(len(data)) || ws[1].Offset > ws[2].Offset {
+			t.Errorf("originalOffsets = %+v, 0 … %d 를 기대했습니다", ws, len(data))
+		}
+	})
+}
+
+func FuzzDartXMLToMarkdown(f *testing.F) {
+	fuzzSeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d := ParseDocument(data)
+		md := d.Markdown()
+		if utf8.Valid(data) && !utf8.ValidString(md) {
+			t.Errorf("유효한 UTF-8 입력에서 잘못된 UTF-8 출력: %q", data)
+		}
+		for _, w := range d.Warnings {
+			if w.Offset < 0 || w.Offset > int64(len(data)) {
+				t.Errorf("경고 오프셋 %d 가 입력(%d바이트) 밖입니다", w.Offset, len(data))
+			}
+		}
+		_ = dartXMLToMarkdown(data)
+	})
+}
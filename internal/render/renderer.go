@@ -2,22 +2,131 @@ package render
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"text/template"
 
 	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/mattn/go-isatty"
 	"golang.org/x/term"
 )
 
 // Renderer wraps glamour.TermRenderer with TTY detection.
 type Renderer struct {
-	style    string
-	noColor  bool
+	style   string
+	noColor bool
+	format  string
+	outPath string
+	tmpl    *template.Template
+	meta    FrontMatter
 }
 
-// New creates a Renderer.
-func New(style string, noColor bool) *Renderer {
-	return &Renderer{style: style, noColor: noColor}
+// New creates a Renderer. format is one of Formats; "" means FormatText.
+func New(style string, noColor bool, format string) *Renderer {
+	if format == "" {
+		format = FormatText
+	}
+	return &Renderer{style: style, noColor: noColor, format: format}
+}
+
+// SetOutputFile makes Output write to path instead of stdout. Text output
+// written to a file is the unrendered markdown.
+func (r *Renderer) SetOutputFile(path string) {
+	r.outPath = path
+}
+
+// SetTemplate makes Output execute t against the command's data instead
+// of rendering the selected format.
+func (r *Renderer) SetTemplate(t *template.Template) {
+	r.tmpl = t
+}
+
+// Meta returns the front matter written with --format markdown, for
+// commands to fill in as they resolve companies and filings.
+func (r *Renderer) Meta() *FrontMatter {
+	return &r.meta
+}
+
+// Machine reports whether output is driven by the data model rather than
+// the markdown view: any format but text and markdown, or a --template.
+func (r *Renderer) Machine() bool {
+	return (r.format != FormatText && r.format != FormatMarkdown) || r.tmpl != nil
+}
+
+// Output writes data in the selected format. A template, JSON and YAML
+// use data directly; csv, tsv and xlsx need data to implement Tabular;
+// text renders the markdown built by md through glamour, and markdown
+// writes it verbatim. md is only called when needed.
+func (r *Renderer) Output(data any, md func() string) error {
+	return r.output(data, md, false)
+}
+
+// OutputWide is Output with PrintWide for the text format.
+func (r *Renderer) OutputWide(data any, md func() string) error {
+	return r.output(data, md, true)
+}
+
+func (r *Renderer) output(data any, md func() string, wide bool) error {
+	if r.format == FormatText && r.outPath == "" && r.tmpl == nil {
+		return r.print(md(), wide)
+	}
+
+	var tables []Table
+	switch r.format {
+	case FormatCSV, FormatTSV, FormatXLSX:
+		t, ok := data.(Tabular)
+		if !ok {
+			return fmt.Errorf("%s 형식은 이 명령에서 지원하지 않습니다 (search, list, finance, view, tables, diff, amendments 전용)", r.format)
+		}
+		tables = t.Tables()
+		if r.format == FormatXLSX && r.outPath == "" && isatty.IsTerminal(os.Stdout.Fd()) {
+			return fmt.Errorf("xlsx 출력은 -o <파일>로 저장 경로를 지정하세요")
+		}
+	}
+
+	w := io.Writer(os.Stdout)
+	var f *os.File
+	if r.outPath != "" {
+		var err error
+		if f, err = os.Create(r.outPath); err != nil {
+			return fmt.Errorf("파일 생성 실패: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	var err error
+	switch {
+	case r.tmpl != nil:
+		if err = r.tmpl.Execute(w, data); err != nil {
+			err = fmt.Errorf("템플릿 실행 실패: %w", err)
+		}
+	case r.format == FormatJSON:
+		err = EncodeJSON(w, data)
+	case r.format == FormatYAML:
+		err = EncodeYAML(w, data)
+	case r.format == FormatCSV:
+		err = WriteDelimited(w, tables, ',')
+	case r.format == FormatTSV:
+		err = WriteDelimited(w, tables, '\t')
+	case r.format == FormatXLSX:
+		err = WriteXLSX(w, tables)
+	case r.format == FormatMarkdown:
+		err = WriteMarkdown(w, r.meta, md())
+	default:
+		_, err = io.WriteString(w, md())
+	}
+	if err != nil {
+		return err
+	}
+	if f != nil {
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("파일 저장 실패: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "저장 완료: %s\n", r.outPath)
+	}
+	return nil
 }
 
 // Print renders markdown and writes it to stdout.
@@ -64,6 +173,20 @@ func (r *Renderer) print(markdown string, wide bool) error {
 	return nil
 }
 
+// ResolvedStyle returns the glamour style name to use, with "auto"
+// resolved against the terminal background. Call it before a full-screen
+// program takes over the terminal, since resolving queries it.
+func (r *Renderer) ResolvedStyle() string {
+	style := r.resolveStyle()
+	if style == "auto" {
+		if lipgloss.HasDarkBackground() {
+			return "dark"
+		}
+		return "light"
+	}
+	return style
+}
+
 func (r *Renderer) resolveStyle() string {
 	if r.noColor || !isatty.IsTerminal(os.Stdout.Fd()) {
 		return "notty"
@@ -0,0 +1,27 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/cache"
+)
+
+// SearchMarkdown renders corp code search results as a table.
+func SearchMarkdown(query string, results []*cache.CorpInfo) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "# 검색 결과: %q\n\n", query)
+	fmt.Fprintf(&sb, "총 **%d**건\n\n", len(results))
+	sb.WriteString("| 기업명 | 종목코드 | Corp Code | 수정일 |\n")
+	sb.WriteString("|--------|----------|-----------|--------|\n")
+
+	for _, r := range results {
+		stock := r.StockCode
+		if stock == "" {
+			stock = "-"
+		}
+		fmt.Fprintf(&sb, "| %s | %s | `%s` | %s |\n",
+			r.CorpName, stock, r.CorpCode, r.ModifyDate)
+	}
+	return sb.String()
+}
@@ -0,0 +1,112 @@
+package render
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// ordinalSpec is a --toc number such as "2" or "2.3".
+	ordinalSpec = regexp.MustCompile(`^\d+(\.\d+)*$`)
+	// markerSpec is a heading marker such as "II", "2.", "(1)" or "가.".
+	markerSpec = regexp.MustCompile(`^\(?(?:[0-9]+|[ivxlcdm]+)[.)]?$|^\(?[가-힣][.)]$`)
+)
+
+// Select returns a copy of d holding only the sections matching spec,
+// or nil when nothing matches.
+//
+// spec is either a --toc number ("2.3") or a "/"-separated path whose
+// parts are heading markers ("II", "2.") or title fragments ("사업의 내용").
+// Each part may match at any depth below the previous one, so
+// "사업의 내용/주요 제품" and "주요 제품" both find the same section.
+func (d *Document) Select(spec string) *Document {
+	spec = strings.TrimSpace(spec)
+	var found []*Section
+	if ordinalSpec.MatchString(spec) {
+		found = findByNumber(d.Sections, spec)
+	} else {
+		var parts []string
+		for _, p := range strings.Split(spec, "/") {
+			if p = normTitle(p); p != "" {
+				parts = append(parts, p)
+			}
+		}
+		if len(parts) == 0 {
+			return nil
+		}
+		found = findByPath(d.Sections, parts)
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	return &Document{Name: d.Name, Title: d.Title, Company: d.Company, CorpCode: d.CorpCode, Sections: found}
+}
+
+func findByNumber(secs []*Section, number string) []*Section {
+	for _, s := range secs {
+		if s.Number == number {
+			return []*Section{s}
+		}
+		if strings.HasPrefix(number, s.Number+".") {
+			return findByNumber(s.Sections, number)
+		}
+	}
+	return nil
+}
+
+// findByPath collects the outermost sections matching the last part of
+// parts, descending through sections that match earlier parts.
+func findByPath(secs []*Section, parts []string) []*Section {
+	var out []*Section
+	for _, s := range secs {
+		switch {
+		case !sectionMatches(s, parts[0]):
+			out = append(out, findByPath(s.Sections, parts)...)
+		case len(parts) == 1:
+			out = append(out, s)
+		default:
+			out = append(out, findByPath(s.Sections, parts[1:])...)
+		}
+	}
+	return out
+}
+
+// sectionMatches compares a normalised spec part against a section title.
+// Markers only match the title's leading marker, so "I" does not pick up
+// "II." or "III.".
+func sectionMatches(s *Section, part string) bool {
+	title := normTitle(s.Title)
+	if markerSpec.MatchString(part) {
+		fields := strings.Fields(title)
+		return len(fields) > 0 && trimMarker(fields[0]) == trimMarker(part)
+	}
+	return strings.Contains(title, part)
+}
+
+func normTitle(s string) string { return strings.ToLower(collapse(s)) }
+
+func trimMarker(s string) string { return strings.Trim(s, "().") }
+
+// TOCMarkdown lists each document's sections as a nested list, with the
+// numbers accepted by --section.
+func TOCMarkdown(docs []*Document) string {
+	var sb strings.Builder
+	for _, d := range docs {
+		if len(docs) > 1 {
+			fmt.Fprintf(&sb, "## %s\n\n", d.Name)
+		} else if d.Title != "" {
+			fmt.Fprintf(&sb, "# %s\n\n", d.Title)
+		}
+		if len(d.TOC) == 0 {
+			sb.WriteString("*목차 정보가 없습니다.*\n\n")
+			continue
+		}
+		for _, e := range d.TOC {
+			depth := strings.Count(e.Number, ".")
+			fmt.Fprintf(&sb, "%s- `%s` %s\n", strings.Repeat("  ", depth), e.Number, e.Title)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
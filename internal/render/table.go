@@ -0,0 +1,145 @@
+package render
+
+import (
+	"encoding/csv"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/seapy/dartcli/internal/api"
+	"github.com/seapy/dartcli/internal/cache"
+)
+
+// Table is a sheet of cells for csv, tsv and xlsx export. Cells are
+// string, int64 (exported as a raw integer), float64 or nil (empty).
+type Table struct {
+	Name   string
+	Header []string
+	Rows   [][]any
+}
+
+// Tabular is implemented by command data that can be exported as
+// csv, tsv or xlsx.
+type Tabular interface {
+	Tables() []Table
+}
+
+// utf8BOM makes Excel open UTF-8 CSV files with Korean text correctly.
+const utf8BOM = "\ufeff"
+
+// WriteDelimited writes tables as CSV (comma ',') or TSV (tab '\t').
+// Tables with the same header share one header row, since multi-table
+// data (one table per 재무제표) carries the table name as a column; a
+// table with a different header starts after a blank line with its own.
+// CSV starts with a UTF-8 BOM.
+func WriteDelimited(w io.Writer, tables []Table, comma rune) error {
+	if comma == ',' {
+		if _, err := io.WriteString(w, utf8BOM); err != nil {
+			return err
+		}
+	}
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	for i, t := range tables {
+		if i == 0 || !slices.Equal(t.Header, tables[i-1].Header) {
+			if i > 0 {
+				if err := cw.Write(nil); err != nil {
+					return err
+				}
+			}
+			if err := cw.Write(t.Header); err != nil {
+				return err
+			}
+		}
+		for _, row := range t.Rows {
+			rec := make([]string, len(row))
+			for j, c := range row {
+				rec[j] = cellString(c)
+			}
+			if err := cw.Write(rec); err != nil {
+				return err
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func cellString(c any) string {
+	switch v := c.(type) {
+	case nil:
+		return ""
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case string:
+		return v
+	default:
+		return ""
+	}
+}
+
+// amountCellValue parses a DART amount into an int64 cell, or nil when blank.
+func amountCellValue(s string) any {
+	if v, ok := ParseAmount(s); ok {
+		return v
+	}
+	return nil
+}
+
+// CorpList is search output.
+type CorpList []*cache.CorpInfo
+
+// Tables implements Tabular.
+func (l CorpList) Tables() []Table {
+	t := Table{Name: "검색 결과", Header: []string{"기업명", "종목코드", "Corp Code", "수정일"}}
+	for _, c := range l {
+		t.Rows = append(t.Rows, []any{c.CorpName, c.StockCode, c.CorpCode, c.ModifyDate})
+	}
+	return []Table{t}
+}
+
+// DisclosureList is list output.
+type DisclosureList []api.DisclosureItem
+
+// Tables implements Tabular.
+func (l DisclosureList) Tables() []Table {
+	t := Table{Name: "공시 목록", Header: []string{"접수일자", "회사명", "종목코드", "법인구분", "보고서명", "제출인", "비고", "접수번호"}}
+	for _, it := range l {
+		t.Rows = append(t.Rows, []any{it.RceptDt, it.CorpName, it.StockCode, it.CorpCls,
+			strings.TrimSpace(it.ReportNm), it.Flr, it.RmFlag, it.RceptNo})
+	}
+	return []Table{t}
+}
+
+// FinanceStatements is finance output.
+type FinanceStatements []api.FinanceAccount
+
+// Tables implements Tabular with one table per 재무제표 (SjNm), in the
+// order the statements first appear. Amounts are raw integers.
+func (s FinanceStatements) Tables() []Table {
+	header := []string{"재무제표", "구분", "계정과목", "당기", "당기금액", "전기", "전기금액", "전전기", "전전기금액", "통화"}
+	var tables []Table
+	index := map[string]int{}
+	for _, a := range s {
+		i, ok := index[a.SjNm]
+		if !ok {
+			i = len(tables)
+			index[a.SjNm] = i
+			tables = append(tables, Table{Name: a.SjNm, Header: header})
+		}
+		tables[i].Rows = append(tables[i].Rows, []any{
+			a.SjNm, a.FsDiv, a.AccountNm,
+			a.Thstrm_dt, amountCellValue(a.Thstrm_amount),
+			a.Frmtrm_dt, amountCellValue(a.Frmtrm_amount),
+			a.Bfefrmtrm_dt, amountCellValue(a.Bfefrmtrm_amount),
+			a.Currency,
+		})
+	}
+	if len(tables) == 0 {
+		tables = append(tables, Table{Name: "재무정보", Header: header})
+	}
+	return tables
+}
@@ -0,0 +1,100 @@
+package render
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+)
+
+var testStatements = FinanceStatements{
+	{SjNm: "재무상태표", FsDiv: "CFS", AccountNm: "자산총계", Thstrm_amount: "455,905,980,000,000", Frmtrm_amount: "448,424,507,000,000"},
+	{SjNm: "손익계산서", FsDiv: "CFS", AccountNm: "매출액", Thstrm_amount: "258,935,494,000,000", Frmtrm_amount: "-"},
+	{SjNm: "재무상태표", FsDiv: "CFS", AccountNm: "부채총계", Thstrm_amount: "92,228,115,000,000"},
+}
+
+func TestFinanceStatementsTables(t *testing.T) {
+	tables := testStatements.Tables()
+	if len(tables) != 2 || tables[0].Name != "재무상태표" || len(tables[0].Rows) != 2 {
+		t.Fatalf("SjNm별 표 분리 오류: %+v", tables)
+	}
+	if v, ok := tables[0].Rows[0][4].(int64); !ok || v != 455_905_980_000_000 {
+		t.Errorf("당기금액은 원 단위 정수여야 함: %#v", tables[0].Rows[0][4])
+	}
+	if tables[1].Rows[0][6] != nil {
+		t.Errorf("\"-\" 금액은 빈 칸이어야 함: %#v", tables[1].Rows[0][6])
+	}
+}
+
+func TestWriteDelimited(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteDelimited(&buf, testStatements.Tables(), ','); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if !strings.HasPrefix(out, utf8BOM+"재무제표,구분,계정과목") {
+		t.Errorf("CSV는 BOM과 헤더 한 줄로 시작해야 함: %q", out[:40])
+	}
+	if strings.Count(out, "\n") != 4 || !strings.Contains(out, ",455905980000000,") {
+		t.Errorf("CSV 본문 오류:\n%s", out)
+	}
+
+	buf.Reset()
+	list := DisclosureList{{RceptDt: "20240315", CorpName: "삼성전자", ReportNm: "사업보고서 (2023.12)", RceptNo: "20240315000123"}}
+	if err := WriteDelimited(&buf, list.Tables(), '\t'); err != nil {
+		t.Fatal(err)
+	}
+	if strings.HasPrefix(buf.String(), utf8BOM) || !strings.Contains(buf.String(), "20240315\t삼성전자\t") {
+		t.Errorf("TSV 출력 오류: %q", buf.String())
+	}
+	buf.Reset()
+	mixed := []Table{
+		{Header: []string{"a", "b"}, Rows: [][]any{{"x", 1.5}}},
+		{Header: []string{"c"}, Rows: [][]any{{int64(2)}}},
+	}
+	if err := WriteDelimited(&buf, mixed, '\t'); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != "a\tb\nx\t1.5\n\nc\n2\n" {
+		t.Errorf("헤더가 다른 표는 빈 줄 뒤에 자기 헤더로 시작해야 함: %q", got)
+	}
+}
+
+func TestWriteXLSX(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteXLSX(&buf, testStatements.Tables()); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{}
+	for _, f := range zr.File {
+		rc, _ := f.Open()
+		b, _ := io.ReadAll(rc)
+		rc.Close()
+		files[f.Name] = string(b)
+	}
+	if !strings.Contains(files["xl/workbook.xml"], `<sheet name="재무상태표"`) ||
+		!strings.Contains(files["xl/workbook.xml"], `<sheet name="손익계산서"`) {
+		t.Errorf("시트 이름 오류: %s", files["xl/workbook.xml"])
+	}
+	if !strings.Contains(files["xl/worksheets/sheet1.xml"], `<c r="E2" s="1"><v>455905980000000</v></c>`) {
+		t.Errorf("숫자 셀 오류: %s", files["xl/worksheets/sheet1.xml"])
+	}
+}
+
+func TestSheetNames(t *testing.T) {
+	got := sheetNames([]Table{{Name: "a/b"}, {Name: "A/B"}, {Name: ""}, {Name: strings.Repeat("가", 40)}})
+	want := []string{"a_b", "A_B (2)", "Sheet3", strings.Repeat("가", 31)}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("sheetNames[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+	if columnName(0) != "A" || columnName(25) != "Z" || columnName(26) != "AA" {
+		t.Errorf("columnName 오류")
+	}
+}
@@ -0,0 +1,77 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// TemplateFuncs are the helpers available to --template. Amount arguments
+// may be DART strings ("1,234") or numbers from the data model.
+var TemplateFuncs = template.FuncMap{
+	"FormatAmount": func(v any) string { return FormatAmount(templateString(v)) },
+	"FormatDate":   func(v any) string { return FormatDate(templateString(v)) },
+	"GrowthRate": func(current, previous any) string {
+		return GrowthRate(templateString(current), templateString(previous))
+	},
+	"CorpClassLabel": CorpClassLabel,
+}
+
+func templateString(v any) string {
+	switch n := v.(type) {
+	case string:
+		return n
+	case int64:
+		return strconv.FormatInt(n, 10)
+	case int:
+		return strconv.Itoa(n)
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	case *int64:
+		if n == nil {
+			return ""
+		}
+		return strconv.FormatInt(*n, 10)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(n)
+	}
+}
+
+// LoadTemplate resolves a --template value. A value containing "{{" is an
+// inline template; otherwise it is a file path, or a name looked up in dir
+// as-is and with a .tmpl extension.
+func LoadTemplate(spec, dir string) (*template.Template, error) {
+	if strings.Contains(spec, "{{") {
+		t, err := template.New("inline").Funcs(TemplateFuncs).Parse(spec)
+		if err != nil {
+			return nil, fmt.Errorf("템플릿 파싱 실패: %w", err)
+		}
+		return t, nil
+	}
+
+	candidates := []string{spec}
+	if dir != "" && !strings.ContainsRune(spec, os.PathSeparator) {
+		candidates = append(candidates, filepath.Join(dir, spec), filepath.Join(dir, spec+".tmpl"))
+	}
+	for _, path := range candidates {
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("템플릿 읽기 실패: %w", err)
+		}
+		t, err := template.New(filepath.Base(path)).Funcs(TemplateFuncs).Parse(string(data))
+		if err != nil {
+			return nil, fmt.Errorf("템플릿 파싱 실패: %w", err)
+		}
+		return t, nil
+	}
+	return nil, fmt.Errorf("템플릿을 찾을 수 없습니다: %s (파일 경로 또는 %s 의 이름)", spec, dir)
+}
@@ -0,0 +1,55 @@
+package render
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/seapy/dartcli/internal/api"
+)
+
+func TestLoadTemplate(t *testing.T) {
+	dir := t.TempDir()
+	named := "{{range .}}{{.CorpName}} {{CorpClassLabel .CorpCls}} {{FormatDate .RceptDt}}\n{{end}}"
+	if err := os.WriteFile(filepath.Join(dir, "brief.tmpl"), []byte(named), 0644); err != nil {
+		t.Fatal(err)
+	}
+	items := DisclosureList{{CorpName: "삼성전자", CorpCls: "Y", RceptDt: "20240315"}}
+
+	for _, spec := range []string{"brief", filepath.Join(dir, "brief.tmpl"), named} {
+		tmpl, err := LoadTemplate(spec, dir)
+		if err != nil {
+			t.Fatalf("LoadTemplate(%q): %v", spec, err)
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, items); err != nil {
+			t.Fatal(err)
+		}
+		if got := sb.String(); got != "삼성전자 유가증권시장 2024-03-15\n" {
+			t.Errorf("LoadTemplate(%q) 출력 = %q", spec, got)
+		}
+	}
+
+	if _, err := LoadTemplate("missing", dir); err == nil {
+		t.Error("없는 템플릿은 오류여야 함")
+	}
+}
+
+func TestTemplateFuncsAcceptNumbers(t *testing.T) {
+	tmpl, err := LoadTemplate(`{{FormatAmount .Total}} {{GrowthRate .Thstrm_amount .Frmtrm_amount}}`, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := struct {
+		Total int64
+		api.FinanceAccount
+	}{1_500_000_000, api.FinanceAccount{Thstrm_amount: "110", Frmtrm_amount: "100"}}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		t.Fatal(err)
+	}
+	if got := sb.String(); got != FormatAmount("1500000000")+" +10.0%" {
+		t.Errorf("출력 = %q", got)
+	}
+}
@@ -0,0 +1,280 @@
+package render
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Unit is the unit a table's figures are stated in, as written in its
+// caption ("백만원", "천주, %"). Scale is the number of won per unit of the
+// caption's monetary unit, or 0 when the table states no won unit.
+type Unit struct {
+	Text  string  `json:"text"`
+	Money string  `json:"money,omitempty"`
+	Scale float64 `json:"scale,omitempty"`
+}
+
+var (
+	// unitCaption matches a bracketed caption such as "(단위 : 백만원)" or
+	// "[단위 천주, %]", or a line that is only "단위: 원". Prose that merely
+	// mentions 단위 ("사업부문 단위로 …") does not match.
+	unitCaption = regexp.MustCompile(`[(\[（]\s*단\s*위\s*(?:[:：]\s*|\s+)([^)\]）\n]+)[)\]）]|(?m:^\s*단\s*위\s*[:：]\s*([^)\]）\n]+)$)`)
+	// moneyUnit matches a won-based unit such as "원", "천원" or "백만원".
+	moneyUnit = regexp.MustCompile(`^(조|억|천만|백만|십만|만|천)?\s*원$`)
+	// trailingUnit matches a label's own unit: "주당 배당금(원)".
+	trailingUnit = regexp.MustCompile(`\(([^()]*)\)\s*$`)
+)
+
+var moneyScale = map[string]float64{
+	"": 1, "천": 1e3, "만": 1e4, "십만": 1e5, "백만": 1e6, "천만": 1e7, "억": 1e8, "조": 1e12,
+}
+
+// TargetUnits lists the units accepted by view --unit.
+var TargetUnits = []string{"원", "천원", "백만원", "억원", "조원"}
+
+// ParseUnit extracts the unit from a caption such as "(단위 : 백만원)".
+// It returns nil when text does not state one.
+func ParseUnit(text string) *Unit {
+	start, end, ok := unitSpan(text)
+	if !ok {
+		return nil
+	}
+	u := &Unit{Text: strings.TrimSpace(text[start:end])}
+	if u.Text == "" {
+		return nil
+	}
+	for _, part := range strings.FieldsFunc(u.Text, func(r rune) bool { return r == ',' || r == '/' || r == '，' }) {
+		part = strings.TrimSpace(part)
+		if scale, ok := moneyUnitScale(part); ok {
+			u.Money, u.Scale = strings.ReplaceAll(part, " ", ""), scale
+			break
+		}
+	}
+	return u
+}
+
+// TargetUnit validates a view --unit value.
+func TargetUnit(s string) (*Unit, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+	if !strings.HasSuffix(s, "원") {
+		s += "원"
+	}
+	for _, t := range TargetUnits {
+		if s == t {
+			scale, _ := moneyUnitScale(t)
+			return &Unit{Text: t, Money: t, Scale: scale}, nil
+		}
+	}
+	return nil, fmt.Errorf("지원하지 않는 단위입니다: %s (%s 중 하나)", s, strings.Join(TargetUnits, ", "))
+}
+
+func moneyUnitScale(s string) (float64, bool) {
+	m := moneyUnit.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, false
+	}
+	return moneyScale[m[1]], true
+}
+
+// ParseNumber parses a figure as written in DART tables: "1,234",
+// "(1,234)" and "△1,234" for negatives, "12.5%" as 12.5. "-" and text
+// are not numbers.
+func ParseNumber(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	neg := false
+	if strings.HasPrefix(s, "(") && strings.HasSuffix(s, ")") {
+		s, neg = strings.TrimSpace(s[1:len(s)-1]), true
+	}
+	s = strings.TrimSpace(strings.TrimSuffix(s, "%"))
+	for _, p := range []string{"-", "+", "△", "▲", "▽"} {
+		if strings.HasPrefix(s, p) {
+			s = strings.TrimSpace(s[len(p):])
+			neg = neg != (p != "+")
+			break
+		}
+	}
+	digits, dot := 0, false
+	for i, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == ',' && i > 0 && !dot:
+		case r == '.' && !dot && i > 0:
+			dot = true
+		default:
+			return 0, false
+		}
+	}
+	if digits == 0 {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(strings.ReplaceAll(s, ",", ""), 64)
+	if err != nil {
+		return 0, false
+	}
+	if neg {
+		v = -v
+	}
+	return v, true
+}
+
+// nonMoneyWords mark a row or column label whose figures are not amounts.
+var nonMoneyWords = []string{"%", "율", "비중", "비율", "수량", "주식수", "인원"}
+
+// otherUnits are label units ("배당성향(%)", "발행주식(천주)") whose figures
+// are not won amounts.
+var otherUnits = []string{"%", "%p", "배", "명", "건", "개", "회", "년", "개월", "일", "천주", "만주", "백만주",
+	"USD", "US$", "$", "달러", "천달러", "EUR", "유로", "JPY", "엔", "CNY", "위안"}
+
+// labelScale decides how a row or column label affects the cells under it:
+// a trailing won unit ("배당금(원)") gives that scale, another unit or a
+// ratio/count word excludes the cells (skip), and otherwise the table's
+// unit applies (scale 0).
+func labelScale(label string) (scale float64, skip bool) {
+	if m := trailingUnit.FindStringSubmatch(label); m != nil {
+		unit := strings.TrimSpace(m[1])
+		if s, ok := moneyUnitScale(unit); ok {
+			return s, false
+		}
+		for _, u := range otherUnits {
+			if strings.EqualFold(unit, u) {
+				return 0, true
+			}
+		}
+	}
+	// Headers are often letter-spaced ("비 중").
+	compact := strings.ReplaceAll(label, " ", "")
+	for _, w := range nonMoneyWords {
+		if strings.Contains(compact, w) {
+			return 0, true
+		}
+	}
+	return 0, false
+}
+
+// ConvertUnit rescales the table's monetary figures to the won unit to,
+// rewriting cell text with thousands separators (one decimal place when
+// scaling up, as FormatAmount does for 억) and updating Unit. Percentages,
+// counts and rows or columns labelled with a non-won unit are left alone.
+// It reports how many cells changed; tables without a won unit are skipped.
+func (t *DocTable) ConvertUnit(to *Unit) int {
+	if t.Unit == nil || t.Unit.Scale == 0 || to == nil || to.Scale == 0 {
+		return 0
+	}
+	grid := t.Grid()
+	h := min(t.headerRows(), len(grid))
+	if len(grid) == 0 {
+		return 0
+	}
+	labels := t.Layout().Header
+
+	changed := 0
+	for r := h; r < len(grid); r++ {
+		rowScale, rowSkip := labelScale(grid[r][0].Text)
+		for c, cell := range grid[r] {
+			if !cell.Origin || c == 0 {
+				continue
+			}
+			src := &t.Rows[cell.src[0]][cell.src[1]]
+			if src.Value == nil || strings.Contains(src.Text, "%") {
+				continue
+			}
+			colScale, colSkip := labelScale(labels[c])
+			if rowSkip || colSkip {
+				continue
+			}
+			from := t.Unit.Scale
+			if rowScale != 0 {
+				from = rowScale
+			} else if colScale != 0 {
+				from = colScale
+			}
+			v := *src.Value * from / to.Scale
+			src.Value = &v
+			src.Text = formatScaled(v, from < to.Scale)
+			changed++
+		}
+	}
+
+	old := t.Unit.Money
+	t.Unit = &Unit{
+		Text:  strings.Replace(t.Unit.Text, old, to.Money, 1),
+		Money: to.Money,
+		Scale: to.Scale,
+	}
+	return changed
+}
+
+// formatScaled formats a rescaled amount with thousands separators.
+func formatScaled(v float64, fraction bool) string {
+	if !fraction {
+		return commaInt(int64(math.Round(v)))
+	}
+	s := fmt.Sprintf("%.1f", v)
+	intPart, frac, _ := strings.Cut(s, ".")
+	n, _ := strconv.ParseInt(intPart, 10, 64)
+	out := commaInt(n)
+	if n == 0 && strings.HasPrefix(intPart, "-") {
+		out = "-0"
+	}
+	return out + "." + frac
+}
+
+// ConvertUnits rescales every table in the document (see
+// DocTable.ConvertUnit) and rewrites the unit captions next to them.
+func (d *Document) ConvertUnits(to *Unit) {
+	convert := func(blocks []Block) {
+		for i, b := range blocks {
+			if b.Kind != BlockTable || b.Table == nil || b.Table.Unit == nil {
+				continue
+			}
+			old := b.Table.Unit.Money
+			if old == "" {
+				continue
+			}
+			b.Table.ConvertUnit(to)
+			// The parser takes units from the block before the table.
+			if i > 0 && blocks[i-1].Kind != BlockTable {
+				blocks[i-1].Text = replaceUnit(blocks[i-1].Text, old, to.Money)
+			}
+		}
+	}
+	convert(d.Cover)
+	convert(d.Blocks)
+	var walk func(secs []*Section)
+	walk = func(secs []*Section) {
+		for _, s := range secs {
+			convert(s.Blocks)
+			walk(s.Sections)
+		}
+	}
+	walk(d.Sections)
+}
+
+// replaceUnit swaps the won unit in a caption after "단위".
+func replaceUnit(caption, old, to string) string {
+	if old == "" {
+		return caption
+	}
+	start, end, ok := unitSpan(caption)
+	if !ok {
+		return caption
+	}
+	return caption[:start] + strings.Replace(caption[start:end], old, to, 1) + caption[end:]
+}
+
+// unitSpan locates the unit text inside a caption matched by unitCaption.
+func unitSpan(text string) (start, end int, ok bool) {
+	loc := unitCaption.FindStringSubmatchIndex(text)
+	switch {
+	case loc == nil:
+		return 0, 0, false
+	case loc[2] >= 0:
+		return loc[2], loc[3], true
+	default:
+		return loc[4], loc[5], true
+	}
+}
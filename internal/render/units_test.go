@@ -0,0 +1,125 @@
+package render
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestParseUnit(t *testing.T) {
+	tests := []struct {
+		in, text, money string
+		scale           float64
+	}{
+		{"(단위 : 백만원)", "백만원", "백만원", 1e6},
+		{"(단위: 천주, %)", "천주, %", "", 0},
+		{"[단위 : 원, 주]", "원, 주", "원", 1},
+		{"나. 매출실적 (단위 : 억 원)", "억 원", "억원", 1e8},
+		{"（단위 백만원）", "백만원", "백만원", 1e6},
+		{"단위: 원", "원", "원", 1},
+	}
+	for _, tt := range tests {
+		u := ParseUnit(tt.in)
+		if u == nil || u.Text != tt.text || u.Money != tt.money || u.Scale != tt.scale {
+			t.Errorf("ParseUnit(%q) = %+v", tt.in, u)
+		}
+	}
+	for _, in := range []string{
+		"회사의 개요",
+		"당사는 사업부문 단위로 매출을 관리합니다.",
+		"(사업부문 단위로 관리)",
+		"매출은 단위: 원 기준입니다.",
+	} {
+		if u := ParseUnit(in); u != nil {
+			t.Errorf("단위 표기가 아닌 문장 %q 에서 %+v 를 찾았습니다", in, u)
+		}
+	}
+}
+
+func TestParseNumber(t *testing.T) {
+	tests := map[string]float64{
+		"1,234":   1234,
+		"(1,234)": -1234,
+		"△1,234":  -1234,
+		"-12.5":   -12.5,
+		"58.1%":   58.1,
+		"(0.1%)":  -0.1,
+		"+3":      3,
+	}
+	for in, want := range tests {
+		if got, ok := ParseNumber(in); !ok || got != want {
+			t.Errorf("ParseNumber(%q) = %v, %v; want %v", in, got, ok, want)
+		}
+	}
+	for _, in := range []string{"-", "", "DX 부문", "1,2a", ",123", "2024.01.01"} {
+		if v, ok := ParseNumber(in); ok {
+			t.Errorf("ParseNumber(%q) = %v, 숫자가 아니어야 합니다", in, v)
+		}
+	}
+}
+
+func TestConvertUnits(t *testing.T) {
+	data, err := os.ReadFile("testdata/tables/sales.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := ParseDocument(data)
+	if len(doc.Blocks) != 2 || doc.Blocks[1].Table.Unit == nil || doc.Blocks[1].Table.Unit.Scale != 1e6 {
+		t.Fatalf("캡션의 단위가 표에 붙지 않았습니다: %+v", doc.Blocks)
+	}
+
+	u, err := TargetUnit("억")
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc.ConvertUnits(u)
+	md := doc.Markdown()
+	for _, want := range []string{
+		"(단위 : 억원, %)",
+		"| DX 부문 | 제품 | TV, 모니터, 냉장고 등 | 17,488.8 | 58.1% | 16,979.9 | 56.2% |",
+		"|  | 상품 | - | - | - | -21.4 | (0.1%) |",
+	} {
+		if !strings.Contains(md, want) {
+			t.Errorf("환산 결과에 %q 가 없습니다:\n%s", want, md)
+		}
+	}
+
+	// Rows and columns stating their own unit keep or use it.
+	xml := `<P>(단위 : 백만원)</P><TABLE>
+<TR><TH>구 분</TH><TH>당기</TH><TH>비중</TH></TR>
+<TR><TD>당기순이익(백만원)</TD><TD>33,621,363</TD><TD>12</TD></TR>
+<TR><TD>주당 현금배당금(원)</TD><TD>1,446</TD><TD>-</TD></TR>
+<TR><TD>현금배당성향(%)</TD><TD>29.2</TD><TD>-</TD></TR>
+</TABLE>`
+	doc = ParseDocument([]byte(xml))
+	u, _ = TargetUnit("천원")
+	doc.ConvertUnits(u)
+	rows := doc.Blocks[1].Table.Layout().Rows
+	if rows[0][1] != "33,621,363,000" || rows[0][2] != "12" {
+		t.Errorf("금액 행 = %v", rows[0])
+	}
+	if rows[1][1] != "1.4" || rows[2][1] != "29.2" {
+		t.Errorf("자체 단위 행 = %v, %v", rows[1], rows[2])
+	}
+	if _, err := TargetUnit("달러"); err == nil {
+		t.Errorf("지원하지 않는 단위가 허용되었습니다")
+	}
+}
+
+func TestDocumentsTables(t *testing.T) {
+	data, err := os.ReadFile("testdata/tables/sales.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tables := Documents{ParseDocument(data)}.Tables()
+	if len(tables) != 1 || !strings.HasSuffix(tables[0].Name, "[백만원, %]") {
+		t.Fatalf("표 목록 = %+v", tables)
+	}
+	row := tables[0].Rows[0]
+	if v, ok := row[3].(float64); !ok || v != 1748877 {
+		t.Errorf("금액 셀 = %#v, 숫자여야 합니다", row[3])
+	}
+	if row[1] != "제품" {
+		t.Errorf("텍스트 셀 = %#v", row[1])
+	}
+}
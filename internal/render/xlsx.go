@@ -0,0 +1,197 @@
+package render
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// WriteXLSX writes tables as an Excel workbook with one sheet per table.
+// It emits the minimal SpreadsheetML parts Excel and LibreOffice need:
+// inline strings, and numeric cells as numbers with a thousands separator
+// (two decimal places for fractional values).
+func WriteXLSX(w io.Writer, tables []Table) error {
+	zw := zip.NewWriter(w)
+	names := sheetNames(tables)
+
+	var sheets, rels, overrides strings.Builder
+	for i, name := range names {
+		n := i + 1
+		fmt.Fprintf(&sheets, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, xmlEscape(name), n, n)
+		fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, n, n)
+		fmt.Fprintf(&overrides, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, n)
+	}
+	stylesID := len(names) + 1
+	fmt.Fprintf(&rels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, stylesID)
+
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", xmlHeader + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+			overrides.String() + `</Types>`},
+		{"_rels/.rels", xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", xmlHeader + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets>` + sheets.String() + `</sheets></workbook>`},
+		{"xl/_rels/workbook.xml.rels", xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			rels.String() + `</Relationships>`},
+		{"xl/styles.xml", xmlHeader + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+			`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+			`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+			`<borders count="1"><border/></borders>` +
+			`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+			`<cellXfs count="4"><xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+			`<xf numFmtId="3" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+			`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+			`<xf numFmtId="4" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/></cellXfs>` +
+			`</styleSheet>`},
+	}
+	for i, t := range tables {
+		parts = append(parts, struct{ name, body string }{
+			fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), sheetXML(t),
+		})
+	}
+
+	for _, p := range parts {
+		f, err := zw.Create(p.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, p.body); err != nil {
+			return err
+		}
+	}
+	return zw.Close()
+}
+
+const xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+// Cell style indexes into styles.xml cellXfs.
+const (
+	xlsxStyleNumber  = 1
+	xlsxStyleHeader  = 2
+	xlsxStyleDecimal = 3
+)
+
+func sheetXML(t Table) string {
+	var sb strings.Builder
+	sb.WriteString(xmlHeader)
+	sb.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">`)
+	if len(t.Header) > 0 {
+		// Freeze the header row.
+		sb.WriteString(`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>`)
+	}
+	sb.WriteString(`<sheetData>`)
+
+	rowNum := 0
+	writeRow := func(cells []any, style int) {
+		rowNum++
+		fmt.Fprintf(&sb, `<row r="%d">`, rowNum)
+		for i, c := range cells {
+			ref := columnName(i) + strconv.Itoa(rowNum)
+			switch v := c.(type) {
+			case int64:
+				fmt.Fprintf(&sb, `<c r="%s" s="%d"><v>%d</v></c>`, ref, xlsxStyleNumber, v)
+			case float64:
+				style := xlsxStyleDecimal
+				if v == math.Trunc(v) {
+					style = xlsxStyleNumber
+				}
+				fmt.Fprintf(&sb, `<c r="%s" s="%d"><v>%s</v></c>`, ref, style, strconv.FormatFloat(v, 'f', -1, 64))
+			case string:
+				if v == "" {
+					continue
+				}
+				fmt.Fprintf(&sb, `<c r="%s" t="inlineStr"`, ref)
+				if style != 0 {
+					fmt.Fprintf(&sb, ` s="%d"`, style)
+				}
+				fmt.Fprintf(&sb, `><is><t xml:space="preserve">%s</t></is></c>`, xmlEscape(v))
+			}
+		}
+		sb.WriteString(`</row>`)
+	}
+
+	if len(t.Header) > 0 {
+		header := make([]any, len(t.Header))
+		for i, h := range t.Header {
+			header[i] = h
+		}
+		writeRow(header, xlsxStyleHeader)
+	}
+	for _, row := range t.Rows {
+		writeRow(row, 0)
+	}
+	sb.WriteString(`</sheetData></worksheet>`)
+	return sb.String()
+}
+
+// columnName converts a 0-based column index to A, B, …, Z, AA, AB, ….
+func columnName(i int) string {
+	name := ""
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = string(rune('A'+(i-1)%26)) + name
+	}
+	return name
+}
+
+// sheetNames returns unique, Excel-valid sheet names: at most 31 characters
+// without []:*?/\.
+func sheetNames(tables []Table) []string {
+	used := map[string]bool{}
+	names := make([]string, len(tables))
+	for i, t := range tables {
+		base := strings.Map(func(r rune) rune {
+			if strings.ContainsRune(`[]:*?/\`, r) {
+				return '_'
+			}
+			return r
+		}, strings.TrimSpace(t.Name))
+		if base == "" {
+			base = "Sheet" + strconv.Itoa(i+1)
+		}
+		base = truncateRuneCount(base, 31)
+		name := base
+		for n := 2; used[strings.ToLower(name)]; n++ {
+			suffix := fmt.Sprintf(" (%d)", n)
+			name = truncateRuneCount(base, 31-len(suffix)) + suffix
+		}
+		used[strings.ToLower(name)] = true
+		names[i] = name
+	}
+	return names
+}
+
+func truncateRuneCount(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	return string([]rune(s)[:n])
+}
+
+// xmlEscape escapes text for XML and drops characters XML 1.0 forbids.
+func xmlEscape(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '&':
+			sb.WriteString("&amp;")
+		case r == '<':
+			sb.WriteString("&lt;")
+		case r == '>':
+			sb.WriteString("&gt;")
+		case r == '"':
+			sb.WriteString("&quot;")
+		case r == '\t' || r == '\n' || r == '\r' || (r >= 0x20 && r != 0xFFFE && r != 0xFFFF):
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
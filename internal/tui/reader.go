@@ -0,0 +1,531 @@
+// Package tui implements the full-screen document reader behind
+// `dartcli view --tui`.
+package tui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/seapy/dartcli/internal/render"
+)
+
+const helpLine = "↑↓ 이동  enter 열기  space 펼치기  tab 목차/본문  / 검색  n/N 다음/이전  t/T 표  c 복사  q 종료"
+
+var (
+	titleStyle  = lipgloss.NewStyle().Bold(true)
+	cursorStyle = lipgloss.NewStyle().Reverse(true)
+	openStyle   = lipgloss.NewStyle().Bold(true)
+	dimStyle    = lipgloss.NewStyle().Faint(true)
+	sepStyle    = lipgloss.NewStyle().Faint(true)
+	markStyle   = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("3"))
+)
+
+// Run opens the reader on docs and blocks until the user quits. style is
+// a resolved glamour style name (see render.Renderer.ResolvedStyle).
+func Run(title string, docs []*render.Document, style string) error {
+	m := newModel(title, docs, style)
+	_, err := tea.NewProgram(m, tea.WithAltScreen()).Run()
+	return err
+}
+
+type focusArea int
+
+const (
+	focusTree focusArea = iota
+	focusPane
+)
+
+// pane is the rendered content of one node and its descendants.
+type pane struct {
+	lines  []string
+	spans  []int // first line of each block
+	tables []int // first line of each table
+}
+
+type model struct {
+	title string
+	style string
+	roots []*node
+
+	rows   []*node // visible sidebar rows
+	cursor int
+	top    int // first sidebar row on screen
+
+	current *node
+	pane    pane
+	cache   map[*node]pane
+	glam    *glamour.TermRenderer
+	view    viewport.Model
+	focus   focusArea
+
+	width, height int
+	sideWidth     int
+
+	input     textinput.Model
+	searching bool
+	query     string
+	hits      []hit
+	hit       int
+	mark      int // pane line of the current match, -1 if none
+
+	status string
+}
+
+func newModel(title string, docs []*render.Document, style string) *model {
+	in := textinput.New()
+	in.Prompt = "/"
+	in.Placeholder = "검색어"
+
+	m := &model{
+		title: title,
+		style: style,
+		roots: buildTree(docs),
+		cache: map[*node]pane{},
+		input: in,
+		hit:   -1,
+		mark:  -1,
+	}
+	if len(m.roots) == 1 {
+		m.roots[0].expanded = true
+	}
+	m.rows = visible(m.roots)
+	return m
+}
+
+func (m *model) Init() tea.Cmd { return nil }
+
+func (m *model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.resize(msg.Width, msg.Height)
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		m.status = ""
+		switch msg.String() {
+		case "q", "ctrl+c":
+			return m, tea.Quit
+		case "tab":
+			if m.focus == focusTree {
+				m.focus = focusPane
+			} else {
+				m.focus = focusTree
+			}
+			return m, nil
+		case "/":
+			m.searching = true
+			m.input.SetValue("")
+			return m, m.input.Focus()
+		case "n":
+			m.nextHit(1)
+			return m, nil
+		case "N":
+			m.nextHit(-1)
+			return m, nil
+		case "t":
+			m.jumpTable(1)
+			return m, nil
+		case "T":
+			m.jumpTable(-1)
+			return m, nil
+		case "c":
+			m.copySection()
+			return m, nil
+		}
+		if m.focus == focusTree {
+			m.updateTree(msg)
+			return m, nil
+		}
+		return m.updatePane(msg)
+	}
+	return m, nil
+}
+
+func (m *model) updateSearch(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		m.searching = false
+		m.input.Blur()
+		m.search(m.input.Value())
+		return m, nil
+	case "esc", "ctrl+c":
+		m.searching = false
+		m.input.Blur()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *model) updateTree(msg tea.KeyMsg) {
+	if len(m.rows) == 0 {
+		return
+	}
+	n := m.rows[m.cursor]
+	switch msg.String() {
+	case "up", "k":
+		m.moveCursor(m.cursor - 1)
+	case "down", "j":
+		m.moveCursor(m.cursor + 1)
+	case "pgup", "b":
+		m.moveCursor(m.cursor - m.bodyHeight())
+	case "pgdown", "f":
+		m.moveCursor(m.cursor + m.bodyHeight())
+	case "home", "g":
+		m.moveCursor(0)
+	case "end", "G":
+		m.moveCursor(len(m.rows) - 1)
+	case "enter":
+		m.open(n)
+		m.focus = focusPane
+	case " ":
+		if len(n.children) > 0 {
+			n.expanded = !n.expanded
+			m.rows = visible(m.roots)
+		}
+	case "right", "l":
+		if len(n.children) > 0 {
+			n.expanded = true
+			m.rows = visible(m.roots)
+		}
+	case "left", "h":
+		if n.expanded && len(n.children) > 0 {
+			n.expanded = false
+			m.rows = visible(m.roots)
+		} else if n.parent != nil {
+			m.moveCursor(m.indexOf(n.parent))
+		}
+	}
+}
+
+func (m *model) updatePane(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.focus = focusTree
+		return m, nil
+	case "g", "home":
+		m.view.GotoTop()
+		return m, nil
+	case "G", "end":
+		m.view.GotoBottom()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.view, cmd = m.view.Update(msg)
+	return m, cmd
+}
+
+// ── layout ──────────────────────────────────────────────────────────────────
+
+func (m *model) bodyHeight() int {
+	// header line + status line + help line
+	if h := m.height - 3; h > 1 {
+		return h
+	}
+	return 1
+}
+
+func (m *model) resize(width, height int) {
+	m.width, m.height = width, height
+	m.sideWidth = min(max(width/3, 20), 40)
+	paneWidth := max(width-m.sideWidth-1, 10)
+
+	m.view = viewport.New(paneWidth, m.bodyHeight())
+	m.view.SetHorizontalStep(8)
+
+	// Gutter of two columns for the match marker.
+	glam, err := glamour.NewTermRenderer(
+		glamour.WithStylePath(m.style),
+		glamour.WithWordWrap(paneWidth-2),
+		glamour.WithTableWrap(true),
+	)
+	if err != nil {
+		m.status = fmt.Sprintf("렌더러 생성 실패: %v", err)
+	}
+	m.glam = glam
+	m.cache = map[*node]pane{}
+
+	cur := m.current
+	if cur == nil && len(m.rows) > 0 {
+		cur = m.rows[0]
+	}
+	if cur != nil {
+		offset := m.view.YOffset
+		m.open(cur)
+		m.view.SetYOffset(offset)
+	}
+	m.moveCursor(m.cursor)
+}
+
+func (m *model) moveCursor(i int) {
+	m.cursor = min(max(i, 0), len(m.rows)-1)
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	h := m.bodyHeight()
+	if m.cursor < m.top {
+		m.top = m.cursor
+	}
+	if m.cursor >= m.top+h {
+		m.top = m.cursor - h + 1
+	}
+}
+
+func (m *model) indexOf(n *node) int {
+	for i, r := range m.rows {
+		if r == n {
+			return i
+		}
+	}
+	return m.cursor
+}
+
+// ── pane content ────────────────────────────────────────────────────────────
+
+// open shows n in the pane, rendering it on first use.
+func (m *model) open(n *node) {
+	if m.current != n {
+		m.mark = -1
+	}
+	m.current = n
+	p, ok := m.cache[n]
+	if !ok {
+		p = m.renderPane(n)
+		m.cache[n] = p
+	}
+	m.pane = p
+	m.refresh()
+	m.view.GotoTop()
+}
+
+// renderPane renders each block separately so block and table positions
+// in the output are known exactly.
+func (m *model) renderPane(n *node) pane {
+	var p pane
+	for _, b := range subtreeBlocks(n) {
+		p.spans = append(p.spans, len(p.lines))
+		out := b.Markdown()
+		if m.glam != nil {
+			if r, err := m.glam.Render(out); err == nil {
+				out = r
+			}
+		}
+		lines := trimBlank(strings.Split(out, "\n"))
+		if len(lines) == 0 {
+			continue
+		}
+		if len(p.lines) > 0 {
+			p.lines = append(p.lines, "")
+			p.spans[len(p.spans)-1]++
+		}
+		if b.Kind == render.BlockTable {
+			p.tables = append(p.tables, len(p.lines))
+		}
+		p.lines = append(p.lines, lines...)
+	}
+	return p
+}
+
+// refresh pushes the pane lines into the viewport with the match gutter.
+func (m *model) refresh() {
+	var sb strings.Builder
+	for i, l := range m.pane.lines {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		if i == m.mark {
+			sb.WriteString(markStyle.Render("▶ "))
+		} else {
+			sb.WriteString("  ")
+		}
+		sb.WriteString(l)
+	}
+	m.view.SetContent(sb.String())
+}
+
+func trimBlank(lines []string) []string {
+	blank := func(s string) bool { return strings.TrimSpace(ansi.Strip(s)) == "" }
+	for len(lines) > 0 && blank(lines[0]) {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && blank(lines[len(lines)-1]) {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// ── search, tables, copy ────────────────────────────────────────────────────
+
+func (m *model) search(q string) {
+	m.query = strings.ToLower(strings.TrimSpace(q))
+	m.hits, m.hit = nil, -1
+	if m.query == "" {
+		m.mark = -1
+		m.refresh()
+		return
+	}
+	m.hits = search(m.roots, m.query)
+	if len(m.hits) == 0 {
+		m.status = fmt.Sprintf("검색 결과가 없습니다: %s", q)
+		return
+	}
+	// Start from the section being read rather than the top.
+	from := 0
+	if m.current != nil {
+		from = m.current.order
+	}
+	m.hit = len(m.hits) - 1
+	for i, h := range m.hits {
+		if h.node.order >= from {
+			m.hit = i - 1
+			break
+		}
+	}
+	m.nextHit(1)
+}
+
+func (m *model) nextHit(dir int) {
+	if len(m.hits) == 0 {
+		m.status = "검색어가 없습니다 (/ 로 검색)"
+		return
+	}
+	m.hit = (m.hit + dir + len(m.hits)) % len(m.hits)
+	h := m.hits[m.hit]
+
+	for p := h.node.parent; p != nil; p = p.parent {
+		p.expanded = true
+	}
+	m.rows = visible(m.roots)
+	m.moveCursor(m.indexOf(h.node))
+	if m.current != h.node {
+		m.open(h.node)
+	}
+
+	// The node's own blocks come first in its pane, so block i of the
+	// node is span i. Mark the first rendered line showing the query.
+	start := m.pane.spans[h.block]
+	end := len(m.pane.lines)
+	if h.block+1 < len(m.pane.spans) {
+		end = m.pane.spans[h.block+1]
+	}
+	m.mark = start
+	for i := start; i < end; i++ {
+		if strings.Contains(strings.ToLower(ansi.Strip(m.pane.lines[i])), m.query) {
+			m.mark = i
+			break
+		}
+	}
+	m.refresh()
+	m.view.SetYOffset(max(m.mark-2, 0))
+	m.status = fmt.Sprintf("/%s  %d/%d", m.query, m.hit+1, len(m.hits))
+}
+
+func (m *model) jumpTable(dir int) {
+	y := m.view.YOffset
+	target := -1
+	if dir > 0 {
+		for _, t := range m.pane.tables {
+			if t > y {
+				target = t
+				break
+			}
+		}
+	} else {
+		for i := len(m.pane.tables) - 1; i >= 0; i-- {
+			if t := m.pane.tables[i]; t < y {
+				target = t
+				break
+			}
+		}
+	}
+	if target < 0 {
+		m.status = "이동할 표가 없습니다"
+		return
+	}
+	m.view.SetYOffset(target)
+	m.focus = focusPane
+}
+
+// copySection copies the markdown of the section in the pane to the
+// clipboard, falling back to OSC 52 when there is no system clipboard
+// (e.g. over SSH).
+func (m *model) copySection() {
+	n := m.current
+	if n == nil {
+		return
+	}
+	md := markdown(n)
+	if err := clipboard.WriteAll(md); err != nil {
+		if _, err := osc52.New(md).WriteTo(os.Stderr); err != nil {
+			m.status = fmt.Sprintf("복사 실패: %v", err)
+			return
+		}
+	}
+	m.status = fmt.Sprintf("'%s' 섹션을 마크다운으로 복사했습니다 (%d자)", n.title, len([]rune(md)))
+}
+
+// ── view ────────────────────────────────────────────────────────────────────
+
+func (m *model) View() string {
+	if m.width == 0 {
+		return "불러오는 중…"
+	}
+	header := titleStyle.Render(ansi.Truncate(m.title, m.width, "…"))
+
+	h := m.bodyHeight()
+	side := make([]string, h)
+	for i := range side {
+		r := m.top + i
+		line := ""
+		if r < len(m.rows) {
+			line = m.sidebarRow(m.rows[r], r == m.cursor)
+		}
+		side[i] = lipgloss.NewStyle().Width(m.sideWidth).Render(line)
+	}
+	sep := sepStyle.Render(strings.TrimSuffix(strings.Repeat("│\n", h), "\n"))
+	body := lipgloss.JoinHorizontal(lipgloss.Top, strings.Join(side, "\n"), sep, m.view.View())
+
+	status := m.status
+	if m.searching {
+		status = m.input.View()
+	} else if status == "" && m.current != nil {
+		status = fmt.Sprintf("%s  %3.0f%%", m.current.title, m.view.ScrollPercent()*100)
+	}
+	return strings.Join([]string{
+		header,
+		body,
+		ansi.Truncate(status, m.width, "…"),
+		dimStyle.Render(ansi.Truncate(helpLine, m.width, "…")),
+	}, "\n")
+}
+
+func (m *model) sidebarRow(n *node, selected bool) string {
+	icon := "  "
+	if len(n.children) > 0 {
+		icon = "▸ "
+		if n.expanded {
+			icon = "▾ "
+		}
+	}
+	line := ansi.Truncate(strings.Repeat("  ", n.depth)+icon+n.title, m.sideWidth, "…")
+	switch {
+	case selected && m.focus == focusTree:
+		return cursorStyle.Render(line)
+	case selected, n == m.current:
+		return openStyle.Render(line)
+	}
+	return line
+}
@@ -0,0 +1,76 @@
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/seapy/dartcli/internal/render"
+)
+
+const sampleXML = `<DOCUMENT>
+<DOCUMENT-NAME>사업보고서</DOCUMENT-NAME>
+<COMPANY-NAME>테스트</COMPANY-NAME>
+<BODY>
+<SECTION-1><TITLE>I. 회사의 개요</TITLE>
+<SECTION-2><TITLE>1. 회사의 개요</TITLE><P>반도체를 만듭니다.</P></SECTION-2>
+</SECTION-1>
+<SECTION-1><TITLE>II. 사업의 내용</TITLE>
+<P>개요</P>
+<TABLE><TR><TH>부문</TH><TH>매출</TH></TR><TR><TD>DX</TD><TD>100</TD></TR></TABLE>
+<SECTION-2><TITLE>1. 주요 제품</TITLE><P>반도체와 디스플레이</P></SECTION-2>
+</SECTION-1>
+</BODY></DOCUMENT>`
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "tab":
+		return tea.KeyMsg{Type: tea.KeyTab}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestReaderNavigationAndSearch(t *testing.T) {
+	doc := render.ParseDocument([]byte(sampleXML))
+	m := newModel("테스트", []*render.Document{doc}, "notty")
+	m.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+
+	// 표지, I, II
+	if len(m.rows) != 3 || m.rows[0].title != "표지" {
+		t.Fatalf("목차 행 = %d, 첫 행 = %q", len(m.rows), m.rows[0].title)
+	}
+
+	m.Update(key("j"))
+	m.Update(key("l"))
+	if len(m.rows) != 4 || m.rows[2].title != "1. 회사의 개요" {
+		t.Errorf("섹션 펼치기 실패: %d행", len(m.rows))
+	}
+
+	for _, k := range []string{"/", "반", "도", "체", "enter"} {
+		m.Update(key(k))
+	}
+	if len(m.hits) != 2 || m.current.title != "1. 회사의 개요" {
+		t.Fatalf("검색 결과 = %d, 현재 섹션 = %q", len(m.hits), m.current.title)
+	}
+	m.Update(key("n"))
+	if m.current.title != "1. 주요 제품" || !strings.Contains(m.pane.lines[m.mark], "디스플레이") {
+		t.Errorf("다음 결과로 이동하지 못했습니다: %q", m.current.title)
+	}
+	if !m.rows[m.cursor].parent.expanded {
+		t.Errorf("결과의 상위 섹션이 펼쳐지지 않았습니다")
+	}
+
+	m.Update(key("h"))
+	m.Update(key("enter"))
+	if m.current.title != "II. 사업의 내용" || len(m.pane.tables) != 1 {
+		t.Fatalf("섹션 열기 실패: %q, 표 %d개", m.current.title, len(m.pane.tables))
+	}
+	if !strings.Contains(m.View(), "디스플레이") {
+		t.Errorf("하위 섹션 내용이 본문에 없습니다:\n%s", m.View())
+	}
+	if md := markdown(m.current); !strings.HasPrefix(md, "## II. 사업의 내용\n\n개요\n\n| 부문 | 매출 |") {
+		t.Errorf("복사할 마크다운이 다릅니다:\n%s", md)
+	}
+}
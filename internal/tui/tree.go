@@ -0,0 +1,157 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/seapy/dartcli/internal/render"
+)
+
+// node is one entry of the section tree sidebar. Its own content is the
+// heading and blocks before its first subsection; the pane shows a node
+// together with all of its descendants.
+type node struct {
+	title    string
+	parent   *node
+	children []*node
+	depth    int
+	order    int // position in a preorder walk, for searching forward
+	expanded bool
+
+	blocks []render.Block
+	text   []string // lowercased markdown of each block, for search
+}
+
+// buildTree turns parsed files into sidebar nodes. Each file's title,
+// company and cover become a "표지" node ahead of its sections; with
+// several files, each file gets a root node named after it.
+func buildTree(docs []*render.Document) []*node {
+	var roots []*node
+	for i, d := range docs {
+		var parent *node
+		depth := 0
+		if len(docs) > 1 {
+			parent = &node{title: d.Name, expanded: i == 0}
+			roots = append(roots, parent)
+			depth = 1
+		}
+
+		var front []render.Block
+		if d.Title != "" {
+			front = append(front, render.Block{Kind: render.BlockHeading, Level: 1, Text: d.Title})
+		}
+		if d.Company != "" {
+			front = append(front, render.Block{Kind: render.BlockParagraph, Text: "> " + d.Company})
+		}
+		front = append(front, d.Cover...)
+		front = append(front, d.Blocks...)
+
+		var top []*node
+		if len(front) > 0 {
+			top = append(top, &node{title: "표지", blocks: front})
+		}
+		for _, s := range d.Sections {
+			top = append(top, sectionNode(s))
+		}
+		for _, n := range top {
+			n.parent = parent
+			setDepth(n, depth)
+		}
+		if parent != nil {
+			parent.children = top
+		} else {
+			roots = append(roots, top...)
+		}
+	}
+
+	order := 0
+	walk(roots, func(n *node) {
+		n.order = order
+		order++
+		for _, b := range n.blocks {
+			n.text = append(n.text, strings.ToLower(b.Markdown()))
+		}
+	})
+	return roots
+}
+
+func sectionNode(s *render.Section) *node {
+	n := &node{title: s.Title}
+	if s.Title != "" {
+		n.blocks = append(n.blocks, s.Heading())
+	} else {
+		n.title = "(제목 없음)"
+	}
+	n.blocks = append(n.blocks, s.Blocks...)
+	for _, c := range s.Sections {
+		child := sectionNode(c)
+		child.parent = n
+		n.children = append(n.children, child)
+	}
+	return n
+}
+
+func setDepth(n *node, depth int) {
+	n.depth = depth
+	for _, c := range n.children {
+		setDepth(c, depth+1)
+	}
+}
+
+// walk visits nodes in preorder.
+func walk(nodes []*node, fn func(*node)) {
+	for _, n := range nodes {
+		fn(n)
+		walk(n.children, fn)
+	}
+}
+
+// visible flattens the tree as shown in the sidebar, skipping the
+// children of collapsed nodes.
+func visible(nodes []*node) []*node {
+	var out []*node
+	for _, n := range nodes {
+		out = append(out, n)
+		if n.expanded {
+			out = append(out, visible(n.children)...)
+		}
+	}
+	return out
+}
+
+// subtreeBlocks returns n's blocks followed by those of its descendants.
+func subtreeBlocks(n *node) []render.Block {
+	var out []render.Block
+	walk([]*node{n}, func(c *node) {
+		out = append(out, c.blocks...)
+	})
+	return out
+}
+
+// markdown serializes n and its descendants.
+func markdown(n *node) string {
+	var sb strings.Builder
+	for _, b := range subtreeBlocks(n) {
+		sb.WriteString(b.Markdown())
+	}
+	return sb.String()
+}
+
+// hit is a search match: block i of node n's own content.
+type hit struct {
+	node  *node
+	block int
+}
+
+// search returns every block containing q (already lowercased), in
+// document order.
+func search(roots []*node, q string) []hit {
+	var hits []hit
+	walk(roots, func(n *node) {
+		for i, t := range n.text {
+			if strings.Contains(t, q) {
+				hits = append(hits, hit{n, i})
+			}
+		}
+	})
+	return hits
+}